@@ -7,6 +7,7 @@ require (
 	github.com/gophercloud/gophercloud v1.7.0
 	github.com/joho/godotenv v1.5.1
 	github.com/jung-kurt/gofpdf v1.16.2
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -33,5 +34,5 @@ require (
 	golang.org/x/sys v0.8.0 // indirect
 	golang.org/x/text v0.9.0 // indirect
 	google.golang.org/protobuf v1.30.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
+	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"sort"
+
+	"openstack-reporter/internal/models"
+)
+
+// AdminRoles are the role names reviewed as administrative
+var AdminRoles = []string{"admin"}
+
+// AdminHolder is a user holding an admin role on a project, directly or through a group
+type AdminHolder struct {
+	UserID  string `json:"user_id"`
+	Name    string `json:"name"`
+	Domain  string `json:"domain,omitempty"`
+	Role    string `json:"role"`
+	Via     string `json:"via"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+// ProjectAdmins groups the admin role holders of one project
+type ProjectAdmins struct {
+	ProjectID   string        `json:"project_id"`
+	ProjectName string        `json:"project_name"`
+	Cloud       string        `json:"cloud,omitempty"`
+	Admins      []AdminHolder `json:"admins"`
+}
+
+// AdminRoleHolders returns the users holding one of AdminRoles per project. Users of groups
+// with an admin role are listed with the group in Via, direct assignments with "direct".
+func AdminRoleHolders(access []models.ProjectAccess) []ProjectAdmins {
+	var findings []ProjectAdmins
+
+	for _, project := range access {
+		var admins []AdminHolder
+		for _, member := range project.Members {
+			role := adminRole(member.Roles)
+			if role == "" {
+				continue
+			}
+			if member.Type == "user" {
+				admins = append(admins, AdminHolder{
+					UserID:  member.ID,
+					Name:    member.Name,
+					Domain:  member.Domain,
+					Role:    role,
+					Via:     "direct",
+					Enabled: member.Enabled,
+				})
+				continue
+			}
+			for _, user := range member.Users {
+				enabled := user.Enabled
+				admins = append(admins, AdminHolder{
+					UserID:  user.ID,
+					Name:    user.Name,
+					Domain:  member.Domain,
+					Role:    role,
+					Via:     "group " + member.Name,
+					Enabled: &enabled,
+				})
+			}
+		}
+		if len(admins) == 0 {
+			continue
+		}
+
+		sort.Slice(admins, func(i, j int) bool {
+			if admins[i].Name != admins[j].Name {
+				return admins[i].Name < admins[j].Name
+			}
+			return admins[i].Via < admins[j].Via
+		})
+		findings = append(findings, ProjectAdmins{
+			ProjectID:   project.ProjectID,
+			ProjectName: project.ProjectName,
+			Cloud:       project.Cloud,
+			Admins:      admins,
+		})
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Cloud != findings[j].Cloud {
+			return findings[i].Cloud < findings[j].Cloud
+		}
+		return findings[i].ProjectName < findings[j].ProjectName
+	})
+
+	return findings
+}
+
+// adminRole returns the first of roles that is an admin role, empty when there is none
+func adminRole(roles []string) string {
+	for _, role := range roles {
+		for _, adminRole := range AdminRoles {
+			if role == adminRole {
+				return role
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"sort"
+
+	"openstack-reporter/internal/models"
+)
+
+// DanglingRecord is a DNS recordset pointing at addresses that no port or floating IP holds
+type DanglingRecord struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	ZoneName  string   `json:"zone_name"`
+	Type      string   `json:"type"`
+	Records   []string `json:"records"`
+	Addresses []string `json:"unallocated_addresses"`
+}
+
+// ProjectDNSRecords groups dangling DNS records of one project
+type ProjectDNSRecords struct {
+	ProjectID   string           `json:"project_id"`
+	ProjectName string           `json:"project_name"`
+	Cloud       string           `json:"cloud,omitempty"`
+	Records     []DanglingRecord `json:"records"`
+}
+
+// DanglingDNSRecords returns recordsets flagged during collection, grouped per project
+func DanglingDNSRecords(resources []models.Resource) []ProjectDNSRecords {
+	var findings []ProjectDNSRecords
+	projectIndex := make(map[string]int)
+
+	for _, resource := range resources {
+		if resource.Type != "dns_recordset" {
+			continue
+		}
+		var recordset models.DNSRecordSet
+		if !models.DecodeProperties(resource.Properties, &recordset) || len(recordset.UnallocatedAddresses) == 0 {
+			continue
+		}
+
+		key := resource.Cloud + "|" + resource.ProjectID
+		i, exists := projectIndex[key]
+		if !exists {
+			i = len(findings)
+			projectIndex[key] = i
+			findings = append(findings, ProjectDNSRecords{
+				ProjectID:   resource.ProjectID,
+				ProjectName: resource.ProjectName,
+				Cloud:       resource.Cloud,
+			})
+		}
+
+		findings[i].Records = append(findings[i].Records, DanglingRecord{
+			ID:        recordset.ID,
+			Name:      recordset.Name,
+			ZoneName:  recordset.ZoneName,
+			Type:      recordset.Type,
+			Records:   recordset.Records,
+			Addresses: recordset.UnallocatedAddresses,
+		})
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Cloud != findings[j].Cloud {
+			return findings[i].Cloud < findings[j].Cloud
+		}
+		return findings[i].ProjectName < findings[j].ProjectName
+	})
+
+	return findings
+}
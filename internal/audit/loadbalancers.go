@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"sort"
+
+	"openstack-reporter/internal/models"
+)
+
+// UnhealthyMember is a pool member in ERROR or OFFLINE state
+type UnhealthyMember struct {
+	PoolID             string `json:"pool_id"`
+	PoolName           string `json:"pool_name"`
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Address            string `json:"address"`
+	ProtocolPort       int    `json:"protocol_port"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+}
+
+// TLSListener is a listener terminating TLS with the Barbican containers of its certificates
+type TLSListener struct {
+	ID                     string   `json:"id"`
+	Name                   string   `json:"name"`
+	ProtocolPort           int      `json:"protocol_port"`
+	DefaultTLSContainerRef string   `json:"default_tls_container_ref,omitempty"`
+	SNIContainerRefs       []string `json:"sni_container_refs,omitempty"`
+}
+
+// LoadBalancerHealth summarizes the member health of one load balancer
+type LoadBalancerHealth struct {
+	ID                 string            `json:"id"`
+	Name               string            `json:"name"`
+	ProjectID          string            `json:"project_id"`
+	ProjectName        string            `json:"project_name"`
+	Cloud              string            `json:"cloud,omitempty"`
+	Region             string            `json:"region,omitempty"`
+	VipAddress         string            `json:"vip_address"`
+	ProvisioningStatus string            `json:"provisioning_status"`
+	OperatingStatus    string            `json:"operating_status"`
+	Listeners          int               `json:"listeners"`
+	Pools              int               `json:"pools"`
+	Members            int               `json:"members"`
+	UnhealthyMembers   []UnhealthyMember `json:"unhealthy_members"`
+	TLSListeners       []TLSListener     `json:"tls_listeners"`
+	Healthy            bool              `json:"healthy"`
+}
+
+// LoadBalancerHealthStatuses returns the member health of every load balancer, unhealthy ones
+// first. A load balancer is unhealthy when it is in ERROR or DEGRADED state or has members in
+// ERROR or OFFLINE state. With unhealthyOnly healthy load balancers are left out.
+func LoadBalancerHealthStatuses(resources []models.Resource, unhealthyOnly bool) []LoadBalancerHealth {
+	statuses := []LoadBalancerHealth{}
+
+	for _, resource := range resources {
+		if resource.Type != "load_balancer" {
+			continue
+		}
+		var lb models.LoadBalancer
+		if !models.DecodeProperties(resource.Properties, &lb) {
+			continue
+		}
+
+		health := LoadBalancerHealth{
+			ID:                 resource.ID,
+			Name:               resource.Name,
+			ProjectID:          resource.ProjectID,
+			ProjectName:        resource.ProjectName,
+			Cloud:              resource.Cloud,
+			Region:             resource.Region,
+			VipAddress:         lb.VipAddress,
+			ProvisioningStatus: lb.ProvisioningStatus,
+			OperatingStatus:    lb.OperatingStatus,
+			Listeners:          len(lb.Listeners),
+			Pools:              len(lb.Pools),
+			UnhealthyMembers:   []UnhealthyMember{},
+			TLSListeners:       []TLSListener{},
+		}
+		for _, listener := range lb.Listeners {
+			if listener.DefaultTLSContainerRef == "" && len(listener.SNIContainerRefs) == 0 {
+				continue
+			}
+			health.TLSListeners = append(health.TLSListeners, TLSListener{
+				ID:                     listener.ID,
+				Name:                   listener.Name,
+				ProtocolPort:           listener.ProtocolPort,
+				DefaultTLSContainerRef: listener.DefaultTLSContainerRef,
+				SNIContainerRefs:       listener.SNIContainerRefs,
+			})
+		}
+		for _, pool := range lb.Pools {
+			health.Members += len(pool.Members)
+			for _, member := range pool.Members {
+				if !member.Unhealthy() {
+					continue
+				}
+				health.UnhealthyMembers = append(health.UnhealthyMembers, UnhealthyMember{
+					PoolID:             pool.ID,
+					PoolName:           pool.Name,
+					ID:                 member.ID,
+					Name:               member.Name,
+					Address:            member.Address,
+					ProtocolPort:       member.ProtocolPort,
+					ProvisioningStatus: member.ProvisioningStatus,
+					OperatingStatus:    member.OperatingStatus,
+				})
+			}
+		}
+		health.Healthy = len(health.UnhealthyMembers) == 0 &&
+			lb.ProvisioningStatus != "ERROR" &&
+			lb.OperatingStatus != "ERROR" && lb.OperatingStatus != "DEGRADED"
+
+		if unhealthyOnly && health.Healthy {
+			continue
+		}
+		statuses = append(statuses, health)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].Healthy != statuses[j].Healthy {
+			return !statuses[i].Healthy
+		}
+		if statuses[i].Cloud != statuses[j].Cloud {
+			return statuses[i].Cloud < statuses[j].Cloud
+		}
+		if statuses[i].ProjectName != statuses[j].ProjectName {
+			return statuses[i].ProjectName < statuses[j].ProjectName
+		}
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	return statuses
+}
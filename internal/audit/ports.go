@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"sort"
+
+	"openstack-reporter/internal/models"
+)
+
+// OrphanedPort is a port that is down, has no device or belongs to a deleted server or load balancer
+type OrphanedPort struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name,omitempty"`
+	Status      string   `json:"status"`
+	NetworkID   string   `json:"network_id"`
+	NetworkName string   `json:"network_name,omitempty"`
+	DeviceOwner string   `json:"device_owner,omitempty"`
+	DeviceID    string   `json:"device_id,omitempty"`
+	FixedIPs    []string `json:"fixed_ips"`
+	Reasons     []string `json:"reasons"`
+}
+
+// ProjectPorts groups orphaned ports of one project
+type ProjectPorts struct {
+	ProjectID   string         `json:"project_id"`
+	ProjectName string         `json:"project_name"`
+	Cloud       string         `json:"cloud,omitempty"`
+	Ports       []OrphanedPort `json:"ports"`
+}
+
+// OrphanedPorts returns ports flagged during collection, grouped per project
+func OrphanedPorts(resources []models.Resource) []ProjectPorts {
+	var findings []ProjectPorts
+	projectIndex := make(map[string]int)
+
+	for _, resource := range resources {
+		if resource.Type != "port" {
+			continue
+		}
+		var port models.Port
+		if !models.DecodeProperties(resource.Properties, &port) || len(port.OrphanReasons) == 0 {
+			continue
+		}
+
+		key := resource.Cloud + "|" + resource.ProjectID
+		i, exists := projectIndex[key]
+		if !exists {
+			i = len(findings)
+			projectIndex[key] = i
+			findings = append(findings, ProjectPorts{
+				ProjectID:   resource.ProjectID,
+				ProjectName: resource.ProjectName,
+				Cloud:       resource.Cloud,
+			})
+		}
+
+		fixedIPs := []string{}
+		for _, ip := range port.FixedIPs {
+			fixedIPs = append(fixedIPs, ip.IPAddress)
+		}
+
+		findings[i].Ports = append(findings[i].Ports, OrphanedPort{
+			ID:          port.ID,
+			Name:        port.Name,
+			Status:      port.Status,
+			NetworkID:   port.NetworkID,
+			NetworkName: port.NetworkName,
+			DeviceOwner: port.DeviceOwner,
+			DeviceID:    port.DeviceID,
+			FixedIPs:    fixedIPs,
+			Reasons:     port.OrphanReasons,
+		})
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Cloud != findings[j].Cloud {
+			return findings[i].Cloud < findings[j].Cloud
+		}
+		return findings[i].ProjectName < findings[j].ProjectName
+	})
+
+	return findings
+}
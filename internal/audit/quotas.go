@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"sort"
+
+	"openstack-reporter/internal/models"
+)
+
+// DefaultQuotaThreshold is the usage in percent from which a quota is considered near its limit
+const DefaultQuotaThreshold = 80.0
+
+// QuotaStatus is a quota together with its used share of the limit
+type QuotaStatus struct {
+	models.QuotaUsage
+	PercentUsed float64 `json:"percent_used"`
+	NearLimit   bool    `json:"near_limit"`
+}
+
+// ProjectQuotaStatus lists the quotas of a project in one region
+type ProjectQuotaStatus struct {
+	ProjectID      string        `json:"project_id"`
+	ProjectName    string        `json:"project_name"`
+	Cloud          string        `json:"cloud,omitempty"`
+	Region         string        `json:"region,omitempty"`
+	MaxPercentUsed float64       `json:"max_percent_used"`
+	Quotas         []QuotaStatus `json:"quotas"`
+}
+
+// QuotaStatuses computes usage percentages of the collected quotas. Quotas used at least
+// threshold percent are marked near limit. With nearLimitOnly all other quotas are dropped,
+// together with projects that have none left, and projects are ordered by their highest usage.
+func QuotaStatuses(quotas []models.ProjectQuota, threshold float64, nearLimitOnly bool) []ProjectQuotaStatus {
+	var result []ProjectQuotaStatus
+	for _, quota := range quotas {
+		project := ProjectQuotaStatus{
+			ProjectID:   quota.ProjectID,
+			ProjectName: quota.ProjectName,
+			Cloud:       quota.Cloud,
+			Region:      quota.Region,
+			Quotas:      []QuotaStatus{},
+		}
+
+		for _, usage := range quota.Usages {
+			percent := usage.PercentUsed()
+			nearLimit := !usage.Unlimited() && percent >= threshold
+			if nearLimitOnly && !nearLimit {
+				continue
+			}
+			project.Quotas = append(project.Quotas, QuotaStatus{
+				QuotaUsage:  usage,
+				PercentUsed: percent,
+				NearLimit:   nearLimit,
+			})
+			if percent > project.MaxPercentUsed {
+				project.MaxPercentUsed = percent
+			}
+		}
+
+		if nearLimitOnly && len(project.Quotas) == 0 {
+			continue
+		}
+		result = append(result, project)
+	}
+
+	if nearLimitOnly {
+		sort.SliceStable(result, func(i, j int) bool {
+			return result[i].MaxPercentUsed > result[j].MaxPercentUsed
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,73 @@
+package audit
+
+import (
+	"testing"
+
+	"openstack-reporter/internal/models"
+)
+
+func TestQuotaStatusesThreshold(t *testing.T) {
+	tests := []struct {
+		name          string
+		usage         models.QuotaUsage
+		threshold     float64
+		wantPercent   float64
+		wantNearLimit bool
+	}{
+		{name: "below threshold", usage: models.QuotaUsage{Limit: 100, InUse: 79}, threshold: 80, wantPercent: 79},
+		{name: "at threshold", usage: models.QuotaUsage{Limit: 100, InUse: 80}, threshold: 80, wantPercent: 80, wantNearLimit: true},
+		{name: "above threshold", usage: models.QuotaUsage{Limit: 10, InUse: 9}, threshold: 80, wantPercent: 90, wantNearLimit: true},
+		{name: "over limit", usage: models.QuotaUsage{Limit: 10, InUse: 12}, threshold: 80, wantPercent: 120, wantNearLimit: true},
+		{name: "unlimited", usage: models.QuotaUsage{Limit: -1, InUse: 1000}, threshold: 80},
+		{name: "zero limit in use", usage: models.QuotaUsage{Limit: 0, InUse: 1}, threshold: 80, wantPercent: 100, wantNearLimit: true},
+		{name: "zero limit unused", usage: models.QuotaUsage{Limit: 0, InUse: 0}, threshold: 80},
+		{name: "custom threshold", usage: models.QuotaUsage{Limit: 100, InUse: 50}, threshold: 50, wantPercent: 50, wantNearLimit: true},
+		{name: "zero threshold", usage: models.QuotaUsage{Limit: 100, InUse: 0}, threshold: 0, wantNearLimit: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotas := []models.ProjectQuota{{ProjectID: "p1", Usages: []models.QuotaUsage{tt.usage}}}
+			statuses := QuotaStatuses(quotas, tt.threshold, false)
+			if len(statuses) != 1 || len(statuses[0].Quotas) != 1 {
+				t.Fatalf("QuotaStatuses() = %+v, want one project with one quota", statuses)
+			}
+			got := statuses[0].Quotas[0]
+			if got.PercentUsed != tt.wantPercent || got.NearLimit != tt.wantNearLimit {
+				t.Errorf("QuotaStatuses() quota = %.1f%% near limit %v, want %.1f%% near limit %v",
+					got.PercentUsed, got.NearLimit, tt.wantPercent, tt.wantNearLimit)
+			}
+		})
+	}
+}
+
+func TestQuotaStatusesNearLimitOnly(t *testing.T) {
+	quotas := []models.ProjectQuota{
+		{ProjectID: "low", Usages: []models.QuotaUsage{
+			{Resource: "cores", Limit: 100, InUse: 10},
+		}},
+		{ProjectID: "high", Usages: []models.QuotaUsage{
+			{Resource: "cores", Limit: 100, InUse: 95},
+			{Resource: "ram", Limit: 100, InUse: 20},
+		}},
+		{ProjectID: "medium", Usages: []models.QuotaUsage{
+			{Resource: "volumes", Limit: 10, InUse: 8},
+			{Resource: "instances", Limit: -1, InUse: 50},
+		}},
+	}
+
+	statuses := QuotaStatuses(quotas, DefaultQuotaThreshold, true)
+
+	wantProjects := []string{"high", "medium"}
+	if len(statuses) != len(wantProjects) {
+		t.Fatalf("QuotaStatuses() returned %d projects, want %d", len(statuses), len(wantProjects))
+	}
+	for i, want := range wantProjects {
+		if statuses[i].ProjectID != want {
+			t.Errorf("project %d = %s, want %s", i, statuses[i].ProjectID, want)
+		}
+		if len(statuses[i].Quotas) != 1 || !statuses[i].Quotas[0].NearLimit {
+			t.Errorf("project %s quotas = %+v, want only the near-limit one", want, statuses[i].Quotas)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package audit
+
+import (
+	"sort"
+	"strings"
+
+	"openstack-reporter/internal/models"
+)
+
+// SensitivePorts lists ports that should not be reachable from the whole internet
+var SensitivePorts = map[int]string{
+	22:    "SSH",
+	3389:  "RDP",
+	1433:  "MSSQL",
+	1521:  "Oracle",
+	3306:  "MySQL",
+	5432:  "PostgreSQL",
+	5984:  "CouchDB",
+	6379:  "Redis",
+	9200:  "Elasticsearch",
+	11211: "Memcached",
+	27017: "MongoDB",
+}
+
+// udpSensitivePorts lists the sensitive ports whose services also listen on UDP,
+// all the others are reached over TCP only
+var udpSensitivePorts = map[int]bool{
+	3389:  true, // RDP UDP transport
+	11211: true, // Memcached, abused for amplification
+}
+
+// RiskyRule is an ingress rule that opens sensitive ports to any address
+type RiskyRule struct {
+	SecurityGroupID   string   `json:"security_group_id"`
+	SecurityGroupName string   `json:"security_group_name"`
+	RuleID            string   `json:"rule_id"`
+	Protocol          string   `json:"protocol"`
+	PortRangeMin      int      `json:"port_range_min,omitempty"`
+	PortRangeMax      int      `json:"port_range_max,omitempty"`
+	RemoteIPPrefix    string   `json:"remote_ip_prefix"`
+	Services          []string `json:"services"`
+	Servers           []string `json:"servers"`
+}
+
+// ProjectFindings groups risky rules of one project
+type ProjectFindings struct {
+	ProjectID   string      `json:"project_id"`
+	ProjectName string      `json:"project_name"`
+	Cloud       string      `json:"cloud,omitempty"`
+	Rules       []RiskyRule `json:"rules"`
+}
+
+// SecurityGroupFindings returns rules open to 0.0.0.0/0 or ::/0 on sensitive ports, grouped per project
+func SecurityGroupFindings(resources []models.Resource) []ProjectFindings {
+	var findings []ProjectFindings
+	projectIndex := make(map[string]int)
+
+	for _, resource := range resources {
+		if resource.Type != "security_group" {
+			continue
+		}
+		var group models.SecurityGroup
+		if !models.DecodeProperties(resource.Properties, &group) {
+			continue
+		}
+
+		var servers []string
+		for _, server := range group.Servers {
+			servers = append(servers, firstNonEmpty(server.Name, server.ID))
+		}
+
+		for _, rule := range group.Rules {
+			services := exposedServices(rule)
+			if len(services) == 0 {
+				continue
+			}
+
+			key := resource.Cloud + "|" + resource.ProjectID
+			i, exists := projectIndex[key]
+			if !exists {
+				i = len(findings)
+				projectIndex[key] = i
+				findings = append(findings, ProjectFindings{
+					ProjectID:   resource.ProjectID,
+					ProjectName: resource.ProjectName,
+					Cloud:       resource.Cloud,
+				})
+			}
+
+			findings[i].Rules = append(findings[i].Rules, RiskyRule{
+				SecurityGroupID:   group.ID,
+				SecurityGroupName: group.Name,
+				RuleID:            rule.ID,
+				Protocol:          firstNonEmpty(rule.Protocol, "any"),
+				PortRangeMin:      rule.PortRangeMin,
+				PortRangeMax:      rule.PortRangeMax,
+				RemoteIPPrefix:    firstNonEmpty(rule.RemoteIPPrefix, anyAddress(rule.EtherType)),
+				Services:          services,
+				Servers:           servers,
+			})
+		}
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Cloud != findings[j].Cloud {
+			return findings[i].Cloud < findings[j].Cloud
+		}
+		return findings[i].ProjectName < findings[j].ProjectName
+	})
+
+	return findings
+}
+
+// exposedServices returns the sensitive services an ingress rule opens to any address
+func exposedServices(rule models.SecurityGroupRule) []string {
+	if rule.Direction != "ingress" || !opensToAnyAddress(rule) {
+		return nil
+	}
+
+	var tcp, udp bool
+	switch strings.ToLower(rule.Protocol) {
+	case "", "any":
+		tcp, udp = true, true
+	case "tcp", "6":
+		tcp = true
+	case "udp", "17":
+		udp = true
+	default:
+		// ICMP and other protocols have no ports
+		return nil
+	}
+
+	// A rule without a port range opens every port
+	min, max := rule.PortRangeMin, rule.PortRangeMax
+	if min == 0 && max == 0 {
+		min, max = 1, 65535
+	}
+
+	var ports []int
+	for port := range SensitivePorts {
+		if port < min || port > max {
+			continue
+		}
+		if tcp || udp && udpSensitivePorts[port] {
+			ports = append(ports, port)
+		}
+	}
+	sort.Ints(ports)
+
+	services := make([]string, 0, len(ports))
+	for _, port := range ports {
+		services = append(services, SensitivePorts[port])
+	}
+	return services
+}
+
+// opensToAnyAddress reports whether the rule accepts traffic from every address.
+// A rule with neither a remote prefix nor a remote group allows any source.
+func opensToAnyAddress(rule models.SecurityGroupRule) bool {
+	switch rule.RemoteIPPrefix {
+	case "0.0.0.0/0", "::/0":
+		return true
+	case "":
+		return rule.RemoteGroupID == ""
+	}
+	return false
+}
+
+// anyAddress returns the catch-all prefix of an ether type
+func anyAddress(etherType string) string {
+	if etherType == "IPv6" {
+		return "::/0"
+	}
+	return "0.0.0.0/0"
+}
+
+// firstNonEmpty returns the first non-empty string
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
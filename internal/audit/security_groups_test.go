@@ -0,0 +1,92 @@
+package audit
+
+import (
+	"reflect"
+	"testing"
+
+	"openstack-reporter/internal/models"
+)
+
+func TestOpensToAnyAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		rule models.SecurityGroupRule
+		want bool
+	}{
+		{name: "any IPv4 address", rule: models.SecurityGroupRule{RemoteIPPrefix: "0.0.0.0/0"}, want: true},
+		{name: "any IPv6 address", rule: models.SecurityGroupRule{RemoteIPPrefix: "::/0"}, want: true},
+		{name: "no remote at all", rule: models.SecurityGroupRule{}, want: true},
+		{name: "remote group", rule: models.SecurityGroupRule{RemoteGroupID: "group-1"}, want: false},
+		{name: "IPv4 network", rule: models.SecurityGroupRule{RemoteIPPrefix: "10.0.0.0/8"}, want: false},
+		{name: "IPv4 host", rule: models.SecurityGroupRule{RemoteIPPrefix: "192.0.2.10/32"}, want: false},
+		{name: "IPv6 network", rule: models.SecurityGroupRule{RemoteIPPrefix: "2001:db8::/32"}, want: false},
+		{name: "prefix and remote group", rule: models.SecurityGroupRule{RemoteIPPrefix: "0.0.0.0/0", RemoteGroupID: "group-1"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := opensToAnyAddress(tt.rule); got != tt.want {
+				t.Errorf("opensToAnyAddress(%+v) = %v, want %v", tt.rule, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExposedServices(t *testing.T) {
+	rule := func(protocol string, min, max int) models.SecurityGroupRule {
+		return models.SecurityGroupRule{
+			Direction: "ingress", Protocol: protocol, PortRangeMin: min, PortRangeMax: max, RemoteIPPrefix: "0.0.0.0/0",
+		}
+	}
+	allServices := []string{"SSH", "MSSQL", "Oracle", "MySQL", "RDP", "PostgreSQL", "CouchDB", "Redis", "Elasticsearch", "Memcached", "MongoDB"}
+
+	tests := []struct {
+		name string
+		rule models.SecurityGroupRule
+		want []string
+	}{
+		{name: "single tcp port", rule: rule("tcp", 22, 22), want: []string{"SSH"}},
+		{name: "single insensitive port", rule: rule("tcp", 443, 443), want: nil},
+		{name: "tcp range", rule: rule("tcp", 1000, 4000), want: []string{"MSSQL", "Oracle", "MySQL", "RDP"}},
+		{name: "range bounds are inclusive", rule: rule("tcp", 3306, 3389), want: []string{"MySQL", "RDP"}},
+		{name: "numeric tcp protocol", rule: rule("6", 5432, 5432), want: []string{"PostgreSQL"}},
+		{name: "upper case protocol", rule: rule("TCP", 6379, 6379), want: []string{"Redis"}},
+		{name: "all tcp ports", rule: rule("tcp", 0, 0), want: allServices},
+		{name: "any protocol and port", rule: rule("", 0, 0), want: allServices},
+		{name: "any protocol by name", rule: rule("any", 22, 22), want: []string{"SSH"}},
+		{name: "udp is not SSH", rule: rule("udp", 22, 22), want: nil},
+		{name: "numeric udp is not SSH", rule: rule("17", 22, 22), want: nil},
+		{name: "udp memcached", rule: rule("udp", 11211, 11211), want: []string{"Memcached"}},
+		{name: "all udp ports", rule: rule("udp", 0, 0), want: []string{"RDP", "Memcached"}},
+		{name: "udp range", rule: rule("udp", 1, 10000), want: []string{"RDP"}},
+		{name: "icmp", rule: rule("icmp", 0, 0), want: nil},
+		{name: "other protocol", rule: rule("gre", 0, 0), want: nil},
+		{
+			name: "egress",
+			rule: models.SecurityGroupRule{Direction: "egress", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "0.0.0.0/0"},
+			want: nil,
+		},
+		{
+			name: "remote group",
+			rule: models.SecurityGroupRule{Direction: "ingress", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22, RemoteGroupID: "group-1"},
+			want: nil,
+		},
+		{
+			name: "IPv6 any address",
+			rule: models.SecurityGroupRule{Direction: "ingress", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "::/0"},
+			want: []string{"SSH"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := exposedServices(tt.rule)
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("exposedServices(%+v) = %q, want %q", tt.rule, got, tt.want)
+			}
+		})
+	}
+}
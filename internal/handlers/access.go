@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"openstack-reporter/internal/models"
+)
+
+// GetProjectMembers returns the users and groups holding roles on a project, groups with their
+// users. Role assignments are only collected when the credentials may list them. With cloud the
+// project is looked up in that cloud only, since project IDs of different clouds may collide.
+func (h *Handler) GetProjectMembers(c *gin.Context) {
+	report, err := h.loadOrFetchReport(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to load cached data and unable to fetch from OpenStack",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	projectID := c.Param("id")
+	cloud := c.Query("cloud")
+
+	var project *models.Project
+	for i := range report.Projects {
+		if report.Projects[i].ID == projectID && (cloud == "" || report.Projects[i].Cloud == cloud) {
+			project = &report.Projects[i]
+			break
+		}
+	}
+	if project == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":      "Project not found",
+			"project_id": projectID,
+		})
+		return
+	}
+
+	response := gin.H{
+		"project_id":   project.ID,
+		"project_name": project.Name,
+		"cloud":        project.Cloud,
+		"members":      []models.ProjectMember{},
+		"generated_at": report.GeneratedAt,
+	}
+	found := false
+	for _, access := range report.Access {
+		if access.ProjectID == project.ID && access.Cloud == project.Cloud {
+			response["members"] = access.Members
+			response["total_members"] = len(access.Members)
+			found = true
+			break
+		}
+	}
+	if !found {
+		response["total_members"] = 0
+		response["message"] = "No role assignments collected for this project, listing them requires admin credentials"
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"openstack-reporter/internal/audit"
+	"openstack-reporter/internal/models"
+)
+
+// GetSecurityGroupAudit returns security group rules that open sensitive ports to the internet,
+// grouped per project. Accepts the same filters as GetResources.
+func (h *Handler) GetSecurityGroupAudit(c *gin.Context) {
+	report, err := h.loadOrFetchReport(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to load cached data and unable to fetch from OpenStack",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	filteredReport := h.applyFilters(report, c)
+	findings := audit.SecurityGroupFindings(filteredReport.Resources)
+
+	totalRules := 0
+	for _, project := range findings {
+		totalRules += len(project.Rules)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"projects":       findings,
+		"total_projects": len(findings),
+		"total_rules":    totalRules,
+		"generated_at":   report.GeneratedAt,
+	})
+}
+
+// GetOrphanedPortAudit returns ports that are down, have no device or belong to a deleted
+// server or load balancer, grouped per project. Accepts the same filters as GetResources.
+func (h *Handler) GetOrphanedPortAudit(c *gin.Context) {
+	report, err := h.loadOrFetchReport(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to load cached data and unable to fetch from OpenStack",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	filteredReport := h.applyFilters(report, c)
+	findings := audit.OrphanedPorts(filteredReport.Resources)
+
+	totalPorts := 0
+	for _, project := range findings {
+		totalPorts += len(project.Ports)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"projects":       findings,
+		"total_projects": len(findings),
+		"total_ports":    totalPorts,
+		"generated_at":   report.GeneratedAt,
+	})
+}
+
+// GetDNSAudit returns DNS records pointing at addresses of cloud subnets that no port or
+// floating IP holds, grouped per project. Accepts the same filters as GetResources.
+func (h *Handler) GetDNSAudit(c *gin.Context) {
+	report, err := h.loadOrFetchReport(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to load cached data and unable to fetch from OpenStack",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	filteredReport := h.applyFilters(report, c)
+	findings := audit.DanglingDNSRecords(filteredReport.Resources)
+
+	totalRecords := 0
+	for _, project := range findings {
+		totalRecords += len(project.Records)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"projects":       findings,
+		"total_projects": len(findings),
+		"total_records":  totalRecords,
+		"generated_at":   report.GeneratedAt,
+	})
+}
+
+// GetLoadBalancerHealth returns load balancers with their listener, pool and member counts and
+// the members in ERROR or OFFLINE state, unhealthy load balancers first. Only unhealthy load
+// balancers are returned unless all=true. Accepts the same filters as GetResources.
+func (h *Handler) GetLoadBalancerHealth(c *gin.Context) {
+	report, err := h.loadOrFetchReport(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to load cached data and unable to fetch from OpenStack",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	filteredReport := h.applyFilters(report, c)
+	statuses := audit.LoadBalancerHealthStatuses(filteredReport.Resources, c.Query("all") != "true")
+
+	unhealthy := 0
+	unhealthyMembers := 0
+	for _, lb := range statuses {
+		if !lb.Healthy {
+			unhealthy++
+		}
+		unhealthyMembers += len(lb.UnhealthyMembers)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"load_balancers":          statuses,
+		"total_unhealthy":         unhealthy,
+		"total_unhealthy_members": unhealthyMembers,
+		"generated_at":            report.GeneratedAt,
+	})
+}
+
+// loadOrFetchReport returns the cached report, fetching and caching a fresh one when none exists
+func (h *Handler) loadOrFetchReport(ctx context.Context) (*models.ResourceReport, error) {
+	report, err := h.storage.LoadReport()
+	if err == nil {
+		return report, nil
+	}
+	log.Printf("No cached report found, attempting to fetch from OpenStack: %v", err)
+
+	report, err = h.refreshReport(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if saveErr := h.storage.SaveReport(report); saveErr != nil {
+		log.Printf("Warning: Failed to save report to cache: %v", saveErr)
+	}
+	return report, nil
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"openstack-reporter/internal/models"
+)
+
+// GetCapacity returns hypervisors, host aggregates and availability zones per region with
+// the servers of every project rolled up per host. Capacity is only collected with admin
+// credentials. Accepts the region and cloud filters of GetResources.
+func (h *Handler) GetCapacity(c *gin.Context) {
+	report, err := h.loadOrFetchReport(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to load cached data and unable to fetch from OpenStack",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	regions := splitCommaSeparated(c.Query("region"))
+	clouds := splitCommaSeparated(c.Query("cloud"))
+
+	capacity := []models.RegionCapacity{}
+	var totals models.CapacityUsage
+	totalHypervisors := 0
+	for _, regionCapacity := range report.Capacity {
+		if !matchesAny(regionCapacity.Region, regions) || !matchesAny(regionCapacity.Cloud, clouds) {
+			continue
+		}
+		capacity = append(capacity, regionCapacity)
+		totals.Add(regionCapacity.Totals)
+		totalHypervisors += len(regionCapacity.Hypervisors)
+	}
+
+	response := gin.H{
+		"regions":           capacity,
+		"totals":            totals,
+		"total_hypervisors": totalHypervisors,
+		"generated_at":      report.GeneratedAt,
+	}
+	if len(report.Capacity) == 0 {
+		response["message"] = "No capacity data collected, listing hypervisors requires admin credentials"
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -20,9 +22,15 @@ import (
 type Handler struct {
 	storage          *storage.Storage
 	progressChannels map[string]chan openstack.ProgressMessage
+	refreshCancels   map[string]context.CancelFunc
+	attachTimers     map[string]*time.Timer
 	mu               sync.RWMutex
 }
 
+// progressAttachTimeout is how long a refresh with progress waits for its progress stream.
+// Progress events are never dropped, so a refresh nobody watches would stall.
+const progressAttachTimeout = time.Minute
+
 func NewHandler() *Handler {
 	storage := storage.NewStorage()
 	if err := storage.Initialize(); err != nil {
@@ -32,6 +40,8 @@ func NewHandler() *Handler {
 	return &Handler{
 		storage:          storage,
 		progressChannels: make(map[string]chan openstack.ProgressMessage),
+		refreshCancels:   make(map[string]context.CancelFunc),
+		attachTimers:     make(map[string]*time.Timer),
 	}
 }
 
@@ -43,7 +53,7 @@ func (h *Handler) GetResources(c *gin.Context) {
 		log.Printf("No cached report found, attempting to fetch from OpenStack: %v", err)
 
 		// If no cache, try to fetch from OpenStack
-		freshReport, fetchErr := h.fetchFromOpenStack()
+		freshReport, fetchErr := h.refreshReport(c.Request.Context(), "", nil)
 		if fetchErr != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "Failed to load cached data and unable to fetch from OpenStack",
@@ -81,12 +91,22 @@ func (h *Handler) applyFilters(report *models.ResourceReport, c *gin.Context) *m
 	projectIDFilter := c.Query("project_id")
 	typeFilter := c.Query("type")
 	statusFilter := c.Query("status")
+	regionFilter := c.Query("region")
+	cloudFilter := c.Query("cloud")
+	stackFilter := c.Query("stack")
+	metadataFilter := c.Query("metadata")
+	tagFilter := c.Query("tag")
 
 	// Parse comma-separated values if provided
 	var projectNames []string
 	var projectIDs []string
 	var types []string
 	var statuses []string
+	var regions []string
+	var clouds []string
+	var stacks []string
+	var metadata []string
+	var tags []string
 
 	if projectFilter != "" {
 		projectNames = splitCommaSeparated(projectFilter)
@@ -100,6 +120,21 @@ func (h *Handler) applyFilters(report *models.ResourceReport, c *gin.Context) *m
 	if statusFilter != "" {
 		statuses = splitCommaSeparated(statusFilter)
 	}
+	if regionFilter != "" {
+		regions = splitCommaSeparated(regionFilter)
+	}
+	if cloudFilter != "" {
+		clouds = splitCommaSeparated(cloudFilter)
+	}
+	if stackFilter != "" {
+		stacks = splitCommaSeparated(stackFilter)
+	}
+	if metadataFilter != "" {
+		metadata = splitCommaSeparated(metadataFilter)
+	}
+	if tagFilter != "" {
+		tags = splitCommaSeparated(tagFilter)
+	}
 
 	// Filter resources
 	for _, resource := range report.Resources {
@@ -159,6 +194,67 @@ func (h *Handler) applyFilters(report *models.ResourceReport, c *gin.Context) *m
 			}
 		}
 
+		// Filter by region
+		if len(regions) > 0 {
+			found := false
+			for _, r := range regions {
+				if resource.Region == r {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+
+		// Filter by cloud
+		if len(clouds) > 0 {
+			found := false
+			for _, cl := range clouds {
+				if resource.Cloud == cl {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+
+		// Filter by owning Heat stack, "none" matches resources not created by any stack.
+		// Stacks themselves are never drift.
+		if len(stacks) > 0 {
+			found := false
+			for _, st := range stacks {
+				if st == "none" && resource.Stack == nil && resource.Type != "stack" {
+					found = true
+					break
+				}
+				if resource.Stack != nil && (resource.Stack.Name == st || resource.Stack.ID == st) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+
+		// Filter by server metadata and tags, other resource types have neither
+		if len(metadata) > 0 || len(tags) > 0 {
+			var server models.Server
+			if resource.Type != "server" || !models.DecodeProperties(resource.Properties, &server) {
+				continue
+			}
+			if len(metadata) > 0 && !matchesMetadata(server.Metadata, metadata) {
+				continue
+			}
+			if len(tags) > 0 && !matchesTags(server.Tags, tags) {
+				continue
+			}
+		}
+
 		// Resource passed all filters
 		filtered.Resources = append(filtered.Resources, resource)
 	}
@@ -185,6 +281,31 @@ func splitCommaSeparated(s string) []string {
 	return result
 }
 
+// matchesMetadata reports whether metadata matches any of the filters. A filter is either
+// key=value, matching that exact entry, or key, matching any value of the key.
+func matchesMetadata(metadata map[string]string, filters []string) bool {
+	for _, filter := range filters {
+		key, value, hasValue := strings.Cut(filter, "=")
+		actual, exists := metadata[strings.TrimSpace(key)]
+		if exists && (!hasValue || actual == strings.TrimSpace(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTags reports whether tags contain any of the filters
+func matchesTags(tags []string, filters []string) bool {
+	for _, filter := range filters {
+		for _, tag := range tags {
+			if tag == filter {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // calculateSummary calculates summary statistics for filtered resources
 func (h *Handler) calculateSummary(resources []models.Resource) models.Summary {
 	summary := models.Summary{}
@@ -200,6 +321,17 @@ func (h *Handler) calculateSummary(resources []models.Resource) models.Summary {
 
 	// Count by type
 	for _, resource := range resources {
+		// Count by region and type
+		if resource.Region != "" {
+			if summary.ByRegion == nil {
+				summary.ByRegion = make(map[string]map[string]int)
+			}
+			if summary.ByRegion[resource.Region] == nil {
+				summary.ByRegion[resource.Region] = make(map[string]int)
+			}
+			summary.ByRegion[resource.Region][resource.Type]++
+		}
+
 		switch resource.Type {
 		case "server":
 			summary.TotalServers++
@@ -217,15 +349,46 @@ func (h *Handler) calculateSummary(resources []models.Resource) models.Summary {
 			summary.TotalRouters++
 		case "network":
 			summary.TotalNetworks++
+		case "image":
+			summary.TotalImages++
+		case "security_group":
+			summary.TotalSecurityGroups++
+		case "volume_snapshot":
+			summary.TotalVolumeSnapshots++
+		case "volume_backup":
+			summary.TotalVolumeBackups++
+		case "port":
+			summary.TotalPorts++
+		case "container":
+			summary.TotalContainers++
+			summary.AddContainer(resource)
+		case "object_account":
+			summary.AddObjectAccount(resource)
+		case "dns_zone":
+			summary.TotalDNSZones++
+		case "dns_recordset":
+			summary.TotalDNSRecordsets++
+		case "stack":
+			summary.TotalStacks++
 		}
 	}
 
 	return summary
 }
 
-// RefreshResources fetches fresh data from OpenStack and saves it
+// RefreshResources fetches fresh data from OpenStack and saves it.
+// With ?cloud=name only that cloud is refreshed and the other clouds are kept from the stored report.
 func (h *Handler) RefreshResources(c *gin.Context) {
-	report, err := h.fetchFromOpenStack()
+	cloud := c.Query("cloud")
+	if cloud != "" && !isConfiguredCloud(cloud) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Unknown cloud",
+			"details": fmt.Sprintf("cloud %q is not listed in OS_CLOUDS", cloud),
+		})
+		return
+	}
+
+	report, err := h.refreshReport(c.Request.Context(), cloud, nil)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to fetch resources from OpenStack",
@@ -251,14 +414,35 @@ func (h *Handler) RefreshResources(c *gin.Context) {
 	})
 }
 
-// RefreshWithProgress fetches fresh data from OpenStack with progress updates
+// RefreshWithProgress fetches fresh data from OpenStack with progress updates.
+// Accepts the same ?cloud=name parameter as RefreshResources. The refresh runs until it
+// finishes, times out or the progress stream of the session is closed.
 func (h *Handler) RefreshWithProgress(c *gin.Context) {
-	progressChan := make(chan openstack.ProgressMessage, 100)
+	cloud := c.Query("cloud")
+	if cloud != "" && !isConfiguredCloud(cloud) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Unknown cloud",
+			"details": fmt.Sprintf("cloud %q is not listed in OS_CLOUDS", cloud),
+		})
+		return
+	}
+
+	// Buffer absorbs bursts from concurrent project collection, when it is full collection
+	// waits for the progress stream, which cancels the refresh when it is closed
+	progressChan := make(chan openstack.ProgressMessage, 1000)
 	sessionID := fmt.Sprintf("session_%d", time.Now().UnixNano())
 
+	// The refresh outlives this request, it is canceled through its session
+	ctx, cancel := context.WithCancel(context.Background())
+
 	// Store progress channel
 	h.mu.Lock()
 	h.progressChannels[sessionID] = progressChan
+	h.refreshCancels[sessionID] = cancel
+	h.attachTimers[sessionID] = time.AfterFunc(progressAttachTimeout, func() {
+		log.Printf("No progress stream attached to %s, canceling refresh", sessionID)
+		cancel()
+	})
 	h.mu.Unlock()
 
 	// Start background refresh
@@ -267,11 +451,25 @@ func (h *Handler) RefreshWithProgress(c *gin.Context) {
 			// Clean up when goroutine is done
 			h.mu.Lock()
 			delete(h.progressChannels, sessionID)
+			delete(h.refreshCancels, sessionID)
+			h.attachTimers[sessionID].Stop()
+			delete(h.attachTimers, sessionID)
 			h.mu.Unlock()
+			cancel()
 			close(progressChan)
 		}()
 
-		report, err := h.fetchFromOpenStackWithProgress(progressChan)
+		report, err := h.refreshReport(ctx, cloud, progressChan)
+		if errors.Is(err, openstack.ErrRefreshTimedOut) {
+			select {
+			case progressChan <- openstack.ProgressMessage{
+				Type:    "timeout",
+				Message: fmt.Sprintf("Refresh timed out after %s, the previous report is kept", openstack.RefreshTimeout()),
+			}:
+			default:
+			}
+			return
+		}
 		if err != nil {
 			select {
 			case progressChan <- openstack.ProgressMessage{
@@ -319,6 +517,9 @@ func (h *Handler) GetProgress(c *gin.Context) {
 
 	h.mu.RLock()
 	progressChan, exists := h.progressChannels[sessionID]
+	if exists {
+		h.attachTimers[sessionID].Stop()
+	}
 	h.mu.RUnlock()
 
 	if !exists {
@@ -344,15 +545,29 @@ func (h *Handler) GetProgress(c *gin.Context) {
 			fmt.Fprintf(c.Writer, "data: %s\n\n", data)
 			c.Writer.Flush()
 
-			if msg.Type == "complete" || msg.Type == "error" {
+			if msg.Type == "complete" || msg.Type == "error" || msg.Type == "timeout" {
 				return
 			}
 		case <-c.Request.Context().Done():
+			// Nobody is watching anymore, stop the refresh
+			h.cancelRefresh(sessionID)
 			return
 		}
 	}
 }
 
+// cancelRefresh stops the refresh of a progress session
+func (h *Handler) cancelRefresh(sessionID string) {
+	h.mu.RLock()
+	cancel, exists := h.refreshCancels[sessionID]
+	h.mu.RUnlock()
+
+	if exists {
+		log.Printf("Progress stream of %s closed, canceling refresh", sessionID)
+		cancel()
+	}
+}
+
 // ExportToPDF generates and returns a PDF report
 func (h *Handler) ExportToPDF(c *gin.Context) {
 	log.Printf("PDF export requested from %s", c.ClientIP())
@@ -430,7 +645,7 @@ func (h *Handler) GetProjects(c *gin.Context) {
 		log.Printf("No cached report found, attempting to fetch from OpenStack: %v", err)
 
 		// If no cache, try to fetch from OpenStack
-		freshReport, fetchErr := h.fetchFromOpenStack()
+		freshReport, fetchErr := h.refreshReport(c.Request.Context(), "", nil)
 		if fetchErr != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "Failed to load cached data and unable to fetch from OpenStack",
@@ -456,17 +671,21 @@ func (h *Handler) GetProjects(c *gin.Context) {
 }
 
 // fetchFromOpenStack connects to OpenStack and fetches all resources
-func (h *Handler) fetchFromOpenStack() (*models.ResourceReport, error) {
-	client, err := openstack.NewClient()
+func (h *Handler) fetchFromOpenStack(ctx context.Context) (*models.ResourceReport, error) {
+	if cloudNames := openstack.CloudNames(); len(cloudNames) > 0 {
+		return h.fetchClouds(ctx, cloudNames, nil)
+	}
+
+	client, err := openstack.NewClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return client.GetAllResources()
+	return client.GetAllResources(ctx)
 }
 
 // fetchFromOpenStackWithProgress connects to OpenStack and fetches all resources with progress updates
-func (h *Handler) fetchFromOpenStackWithProgress(progressChan chan openstack.ProgressMessage) (*models.ResourceReport, error) {
+func (h *Handler) fetchFromOpenStackWithProgress(ctx context.Context, progressChan chan openstack.ProgressMessage) (*models.ResourceReport, error) {
 	select {
 	case progressChan <- openstack.ProgressMessage{
 		Type:    "start",
@@ -475,7 +694,11 @@ func (h *Handler) fetchFromOpenStackWithProgress(progressChan chan openstack.Pro
 	default:
 	}
 
-	client, err := openstack.NewClient()
+	if cloudNames := openstack.CloudNames(); len(cloudNames) > 0 {
+		return h.fetchClouds(ctx, cloudNames, progressChan)
+	}
+
+	client, err := openstack.NewClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -488,7 +711,172 @@ func (h *Handler) fetchFromOpenStackWithProgress(progressChan chan openstack.Pro
 	default:
 	}
 
-	return client.GetAllResourcesWithProgress(progressChan)
+	return client.GetAllResourcesWithProgress(ctx, progressChan)
+}
+
+// refreshReport fetches a fresh report within REFRESH_TIMEOUT, with progress updates when
+// progressChan is set. When ctx is done or the deadline passes the refresh is aborted
+// with the context error, openstack.ErrRefreshTimedOut for the deadline.
+func (h *Handler) refreshReport(ctx context.Context, cloud string, progressChan chan openstack.ProgressMessage) (*models.ResourceReport, error) {
+	ctx, cancel := openstack.WithRefreshTimeout(ctx)
+	defer cancel()
+
+	report, err := h.collectReport(ctx, cloud, progressChan)
+	if ctxErr := openstack.ContextError(ctx); ctxErr != nil {
+		// Whatever failed last, the refresh stopped because of the context
+		return nil, ctxErr
+	}
+	return report, err
+}
+
+// collectReport fetches a fresh report, with progress updates when progressChan is set.
+// With cloud set only that cloud is collected and replaces its part of the stored report.
+func (h *Handler) collectReport(ctx context.Context, cloud string, progressChan chan openstack.ProgressMessage) (*models.ResourceReport, error) {
+	if cloud == "" {
+		if progressChan == nil {
+			return h.fetchFromOpenStack(ctx)
+		}
+		return h.fetchFromOpenStackWithProgress(ctx, progressChan)
+	}
+
+	fresh, err := h.fetchCloud(ctx, cloud, progressChan)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []*models.ResourceReport
+	if existing, err := h.storage.LoadReport(); err == nil {
+		reports = append(reports, withoutCloud(existing, cloud))
+	}
+	reports = append(reports, fresh)
+
+	return h.mergeReports(reports), nil
+}
+
+// fetchClouds collects the named clouds one after another and merges them into one report.
+// A cloud that fails is skipped so the others are still reported, unless ctx is done.
+func (h *Handler) fetchClouds(ctx context.Context, cloudNames []string, progressChan chan openstack.ProgressMessage) (*models.ResourceReport, error) {
+	var reports []*models.ResourceReport
+	var failures []string
+
+	for _, cloudName := range cloudNames {
+		if err := openstack.ContextError(ctx); err != nil {
+			return nil, err
+		}
+		report, err := h.fetchCloud(ctx, cloudName, progressChan)
+		if err != nil {
+			log.Printf("Warning: Failed to collect cloud %s: %v", cloudName, err)
+			failures = append(failures, fmt.Sprintf("%s: %v", cloudName, err))
+			sendProgress(progressChan, openstack.ProgressMessage{
+				Type:    "cloud_error",
+				Message: fmt.Sprintf("Failed to collect cloud %s: %v", cloudName, err),
+				Cloud:   cloudName,
+			})
+			continue
+		}
+		reports = append(reports, report)
+	}
+
+	if len(reports) == 0 {
+		return nil, fmt.Errorf("failed to collect any cloud: %s", strings.Join(failures, "; "))
+	}
+
+	return h.mergeReports(reports), nil
+}
+
+// fetchCloud collects a single named cloud, with progress updates when progressChan is set
+func (h *Handler) fetchCloud(ctx context.Context, cloudName string, progressChan chan openstack.ProgressMessage) (*models.ResourceReport, error) {
+	sendProgress(progressChan, openstack.ProgressMessage{
+		Type:    "progress",
+		Message: fmt.Sprintf("Connecting to cloud %s...", cloudName),
+		Cloud:   cloudName,
+	})
+
+	client, err := openstack.NewClientForCloud(ctx, cloudName)
+	if err != nil {
+		return nil, err
+	}
+
+	if progressChan == nil {
+		return client.GetAllResources(ctx)
+	}
+	return client.GetAllResourcesWithProgress(ctx, progressChan)
+}
+
+// mergeReports combines per-cloud reports into one report with a recalculated summary
+func (h *Handler) mergeReports(reports []*models.ResourceReport) *models.ResourceReport {
+	merged := &models.ResourceReport{
+		GeneratedAt: time.Now(),
+		Projects:    []models.Project{},
+		Resources:   []models.Resource{},
+	}
+
+	for _, report := range reports {
+		merged.Projects = append(merged.Projects, report.Projects...)
+		merged.Resources = append(merged.Resources, report.Resources...)
+		merged.Quotas = append(merged.Quotas, report.Quotas...)
+		merged.Capacity = append(merged.Capacity, report.Capacity...)
+		merged.Access = append(merged.Access, report.Access...)
+	}
+
+	merged.Summary = h.calculateSummary(merged.Resources)
+	merged.Summary.TotalProjects = len(merged.Projects)
+
+	return merged
+}
+
+// withoutCloud returns a copy of the report without the projects, resources, quotas, capacity and access of cloud
+func withoutCloud(report *models.ResourceReport, cloud string) *models.ResourceReport {
+	result := &models.ResourceReport{
+		GeneratedAt: report.GeneratedAt,
+	}
+	for _, project := range report.Projects {
+		if project.Cloud != cloud {
+			result.Projects = append(result.Projects, project)
+		}
+	}
+	for _, resource := range report.Resources {
+		if resource.Cloud != cloud {
+			result.Resources = append(result.Resources, resource)
+		}
+	}
+	for _, quota := range report.Quotas {
+		if quota.Cloud != cloud {
+			result.Quotas = append(result.Quotas, quota)
+		}
+	}
+	for _, capacity := range report.Capacity {
+		if capacity.Cloud != cloud {
+			result.Capacity = append(result.Capacity, capacity)
+		}
+	}
+	for _, access := range report.Access {
+		if access.Cloud != cloud {
+			result.Access = append(result.Access, access)
+		}
+	}
+	return result
+}
+
+// isConfiguredCloud reports whether cloud is listed in OS_CLOUDS
+func isConfiguredCloud(cloud string) bool {
+	for _, name := range openstack.CloudNames() {
+		if name == cloud {
+			return true
+		}
+	}
+	return false
+}
+
+// sendProgress delivers a progress message without blocking; progressChan may be nil
+func sendProgress(progressChan chan openstack.ProgressMessage, msg openstack.ProgressMessage) {
+	if progressChan == nil {
+		return
+	}
+	select {
+	case progressChan <- msg:
+	default:
+	}
 }
 
 // calculateTypeSummary creates a summary of resources by type
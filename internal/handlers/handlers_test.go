@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"openstack-reporter/internal/models"
+)
+
+func TestMatchesMetadata(t *testing.T) {
+	metadata := map[string]string{"env": "prod", "team": "payments", "empty": ""}
+
+	tests := []struct {
+		name    string
+		filters []string
+		want    bool
+	}{
+		{name: "key and value", filters: []string{"env=prod"}, want: true},
+		{name: "other value", filters: []string{"env=dev"}, want: false},
+		{name: "key only", filters: []string{"team"}, want: true},
+		{name: "missing key", filters: []string{"owner"}, want: false},
+		{name: "empty value", filters: []string{"empty="}, want: true},
+		{name: "spaces around key and value", filters: []string{" env = prod "}, want: true},
+		{name: "any filter matches", filters: []string{"env=dev", "team=payments"}, want: true},
+		{name: "value is case-sensitive", filters: []string{"env=PROD"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesMetadata(metadata, tt.filters); got != tt.want {
+				t.Errorf("matchesMetadata(%q) = %v, want %v", tt.filters, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesTags(t *testing.T) {
+	tags := []string{"web", "critical"}
+
+	tests := []struct {
+		name    string
+		filters []string
+		want    bool
+	}{
+		{name: "tag present", filters: []string{"web"}, want: true},
+		{name: "tag missing", filters: []string{"db"}, want: false},
+		{name: "any filter matches", filters: []string{"db", "critical"}, want: true},
+		{name: "tags are case-sensitive", filters: []string{"Web"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesTags(tags, tt.filters); got != tt.want {
+				t.Errorf("matchesTags(%q) = %v, want %v", tt.filters, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyFiltersMetadataAndTags(t *testing.T) {
+	report := &models.ResourceReport{Resources: []models.Resource{
+		{ID: "web-prod", Type: "server", Properties: models.Server{
+			Metadata: map[string]string{"env": "prod"}, Tags: []string{"web"},
+		}},
+		{ID: "db-prod", Type: "server", Properties: models.Server{
+			Metadata: map[string]string{"env": "prod"}, Tags: []string{"db"},
+		}},
+		{ID: "web-dev", Type: "server", Properties: models.Server{
+			Metadata: map[string]string{"env": "dev"}, Tags: []string{"web"},
+		}},
+		{ID: "untagged", Type: "server", Properties: models.Server{}},
+		{ID: "volume", Type: "volume", Properties: models.Volume{}},
+	}}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "no filter", query: "", want: []string{"web-prod", "db-prod", "web-dev", "untagged", "volume"}},
+		{name: "metadata key and value", query: "metadata=env=prod", want: []string{"web-prod", "db-prod"}},
+		{name: "metadata key", query: "metadata=env", want: []string{"web-prod", "db-prod", "web-dev"}},
+		{name: "metadata alternatives", query: "metadata=env=dev,env=test", want: []string{"web-dev"}},
+		{name: "tag", query: "tag=web", want: []string{"web-prod", "web-dev"}},
+		{name: "tag alternatives", query: "tag=db,critical", want: []string{"db-prod"}},
+		{name: "metadata and tag", query: "metadata=env=prod&tag=web", want: []string{"web-prod"}},
+		{name: "no match", query: "tag=cache", want: nil},
+	}
+
+	gin.SetMode(gin.TestMode)
+	handler := &Handler{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/api/resources?"+tt.query, nil)
+
+			var got []string
+			for _, resource := range handler.applyFilters(report, c).Resources {
+				got = append(got, resource.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("applyFilters(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"openstack-reporter/internal/audit"
+	"openstack-reporter/internal/models"
+)
+
+// GetQuotas returns Nova, Cinder and Neutron quotas per project with the used percentage.
+// With threshold only quotas used at least that many percent are returned.
+// Accepts the project, project_id, region and cloud filters of GetResources.
+func (h *Handler) GetQuotas(c *gin.Context) {
+	threshold := audit.DefaultQuotaThreshold
+	nearLimitOnly := false
+	if value := c.Query("threshold"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || parsed < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid threshold",
+				"details": fmt.Sprintf("threshold must be a non-negative percentage, got %q", value),
+			})
+			return
+		}
+		threshold = parsed
+		nearLimitOnly = true
+	}
+
+	report, err := h.loadOrFetchReport(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to load cached data and unable to fetch from OpenStack",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	projects := audit.QuotaStatuses(filterQuotas(report.Quotas, c), threshold, nearLimitOnly)
+
+	nearLimit := 0
+	for _, project := range projects {
+		for _, quota := range project.Quotas {
+			if quota.NearLimit {
+				nearLimit++
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"projects":         projects,
+		"total_projects":   len(projects),
+		"total_near_limit": nearLimit,
+		"threshold":        threshold,
+		"generated_at":     report.GeneratedAt,
+	})
+}
+
+// filterQuotas applies the project, project_id, region and cloud query filters to quotas
+func filterQuotas(quotas []models.ProjectQuota, c *gin.Context) []models.ProjectQuota {
+	projectNames := splitCommaSeparated(c.Query("project"))
+	projectIDs := splitCommaSeparated(c.Query("project_id"))
+	regions := splitCommaSeparated(c.Query("region"))
+	clouds := splitCommaSeparated(c.Query("cloud"))
+
+	var filtered []models.ProjectQuota
+	for _, quota := range quotas {
+		if !matchesAny(quota.ProjectName, projectNames) ||
+			!matchesAny(quota.ProjectID, projectIDs) ||
+			!matchesAny(quota.Region, regions) ||
+			!matchesAny(quota.Cloud, clouds) {
+			continue
+		}
+		filtered = append(filtered, quota)
+	}
+	return filtered
+}
+
+// matchesAny reports whether value is one of allowed, an empty filter matches everything
+func matchesAny(value string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, candidate := range allowed {
+		if value == candidate {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package models
+
+import "encoding/json"
+
+// DecodeProperties converts resource properties into target. Properties are typed structs
+// right after collection and generic maps once the report was loaded from storage.
+func DecodeProperties(properties interface{}, target interface{}) bool {
+	if properties == nil {
+		return false
+	}
+	data, err := json.Marshal(properties)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, target) == nil
+}
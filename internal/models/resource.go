@@ -9,11 +9,21 @@ type Resource struct {
 	Type         string            `json:"type"`
 	ProjectID    string            `json:"project_id"`
 	ProjectName  string            `json:"project_name"`
+	Cloud        string            `json:"cloud,omitempty"`
+	Region       string            `json:"region,omitempty"`
 	Status       string            `json:"status"`
 	CreatedAt    time.Time         `json:"created_at"`
 	UpdatedAt    time.Time         `json:"updated_at"`
 	Metadata     map[string]string `json:"metadata,omitempty"`
 	Properties   interface{}       `json:"properties,omitempty"`
+	// Stack is the Heat stack that created the resource, nil for resources created by other means
+	Stack        *StackReference   `json:"stack,omitempty"`
+}
+
+// StackReference identifies the Heat stack a resource belongs to
+type StackReference struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
 // Project represents OpenStack project
@@ -23,18 +33,32 @@ type Project struct {
 	Description string `json:"description"`
 	DomainID    string `json:"domain_id"`
 	Enabled     bool   `json:"enabled"`
+	Cloud       string `json:"cloud,omitempty"`
 }
 
 // Server represents OpenStack compute instance
 type Server struct {
-	ID           string            `json:"id"`
-	Name         string            `json:"name"`
-	Status       string            `json:"status"`
-	FlavorName   string            `json:"flavor_name"`
-	FlavorID     string            `json:"flavor_id"`
-	Networks     map[string]string `json:"networks"`
-	CreatedAt    time.Time         `json:"created_at"`
-	UpdatedAt    time.Time         `json:"updated_at"`
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Status           string            `json:"status"`
+	FlavorName       string            `json:"flavor_name"`
+	FlavorID         string            `json:"flavor_id"`
+	ImageID          string            `json:"image_id,omitempty"`
+	ImageName        string            `json:"image_name,omitempty"`
+	KeyName          string            `json:"key_name,omitempty"`
+	AvailabilityZone string            `json:"availability_zone,omitempty"`
+	Host             string            `json:"host,omitempty"`
+	VCPUs            int               `json:"vcpus,omitempty"`
+	RAMMB            int               `json:"ram_mb,omitempty"`
+	Networks         map[string]string `json:"networks"`
+	SecurityGroups   []string          `json:"security_groups,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+	Tags             []string          `json:"tags,omitempty"`
+	PowerState       string            `json:"power_state,omitempty"`
+	Locked           bool              `json:"locked"`
+	AttachedVolumes  []string          `json:"attached_volumes,omitempty"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
 }
 
 // Volume represents OpenStack block storage volume
@@ -57,6 +81,41 @@ type VolumeAttachment struct {
 	Device       string `json:"device,omitempty"`
 }
 
+// VolumeSnapshot represents Cinder volume snapshot
+type VolumeSnapshot struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description,omitempty"`
+	Status       string    `json:"status"`
+	Size         int       `json:"size"`
+	VolumeID     string    `json:"volume_id"`
+	VolumeName   string    `json:"volume_name,omitempty"`
+	VolumeExists bool      `json:"volume_exists"`
+	AgeDays      int       `json:"age_days"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// VolumeBackup represents Cinder volume backup
+type VolumeBackup struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description,omitempty"`
+	Status       string    `json:"status"`
+	Size         int       `json:"size"`
+	VolumeID     string    `json:"volume_id"`
+	VolumeName   string    `json:"volume_name,omitempty"`
+	VolumeExists bool      `json:"volume_exists"`
+	SnapshotID   string    `json:"snapshot_id,omitempty"`
+	Incremental  bool      `json:"incremental"`
+	Container    string    `json:"container,omitempty"`
+	ObjectCount  int       `json:"object_count"`
+	FailReason   string    `json:"fail_reason,omitempty"`
+	AgeDays      int       `json:"age_days"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
 // LoadBalancer represents OpenStack load balancer
 type LoadBalancer struct {
 	ID                string    `json:"id"`
@@ -66,10 +125,76 @@ type LoadBalancer struct {
 	OperatingStatus   string    `json:"operating_status"`
 	VipAddress        string    `json:"vip_address"`
 	VipSubnetID       string    `json:"vip_subnet_id"`
+	Listeners         []LoadBalancerListener `json:"listeners,omitempty"`
+	Pools             []LoadBalancerPool     `json:"pools,omitempty"`
 	CreatedAt         time.Time `json:"created_at"`
 	UpdatedAt         time.Time `json:"updated_at"`
 }
 
+// LoadBalancerListener represents Octavia listener
+type LoadBalancerListener struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Protocol           string `json:"protocol"`
+	ProtocolPort       int    `json:"protocol_port"`
+	DefaultPoolID      string `json:"default_pool_id,omitempty"`
+	ConnectionLimit    int    `json:"connection_limit"`
+	AdminStateUp       bool   `json:"admin_state_up"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+	// Barbican containers of the certificates of TERMINATED_HTTPS listeners
+	DefaultTLSContainerRef string   `json:"default_tls_container_ref,omitempty"`
+	SNIContainerRefs       []string `json:"sni_container_refs,omitempty"`
+}
+
+// LoadBalancerPool represents Octavia pool with its health monitor and members
+type LoadBalancerPool struct {
+	ID                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Protocol           string                     `json:"protocol"`
+	LBAlgorithm        string                     `json:"lb_algorithm"`
+	ListenerIDs        []string                   `json:"listener_ids,omitempty"`
+	AdminStateUp       bool                       `json:"admin_state_up"`
+	ProvisioningStatus string                     `json:"provisioning_status"`
+	OperatingStatus    string                     `json:"operating_status"`
+	HealthMonitor      *LoadBalancerHealthMonitor `json:"health_monitor,omitempty"`
+	Members            []LoadBalancerMember       `json:"members"`
+}
+
+// LoadBalancerHealthMonitor represents Octavia health monitor of a pool
+type LoadBalancerHealthMonitor struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Type               string `json:"type"`
+	Delay              int    `json:"delay"`
+	Timeout            int    `json:"timeout"`
+	MaxRetries         int    `json:"max_retries"`
+	HTTPMethod         string `json:"http_method,omitempty"`
+	URLPath            string `json:"url_path,omitempty"`
+	ExpectedCodes      string `json:"expected_codes,omitempty"`
+	AdminStateUp       bool   `json:"admin_state_up"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+}
+
+// LoadBalancerMember represents Octavia pool member
+type LoadBalancerMember struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Address            string `json:"address"`
+	ProtocolPort       int    `json:"protocol_port"`
+	Weight             int    `json:"weight"`
+	Backup             bool   `json:"backup"`
+	AdminStateUp       bool   `json:"admin_state_up"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+}
+
+// Unhealthy reports whether the member failed its health checks or is in error
+func (m LoadBalancerMember) Unhealthy() bool {
+	return m.OperatingStatus == "ERROR" || m.OperatingStatus == "OFFLINE" || m.ProvisioningStatus == "ERROR"
+}
+
 // FloatingIP represents OpenStack floating IP
 type FloatingIP struct {
 	ID                   string    `json:"id"`
@@ -79,6 +204,7 @@ type FloatingIP struct {
 	PortID               string    `json:"port_id,omitempty"`
 	AttachedResourceName string    `json:"attached_resource_name,omitempty"`
 	FloatingNetworkID    string    `json:"floating_network_id"`
+	DNSNames             []string  `json:"dns_names,omitempty"`
 	CreatedAt            time.Time `json:"created_at"`
 	UpdatedAt            time.Time `json:"updated_at"`
 }
@@ -101,15 +227,205 @@ type VPNService struct {
 
 // Cluster represents Kubernetes cluster
 type Cluster struct {
-	ID                string    `json:"id"`
-	Name              string    `json:"name"`
-	Status            string    `json:"status"`
-	ClusterTemplateID string    `json:"cluster_template_id"`
-	NodeCount         int       `json:"node_count"`
-	MasterCount       int       `json:"master_count"`
-	KeyPair           string    `json:"keypair"`
-	CreatedAt         time.Time `json:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	ID                  string             `json:"id"`
+	Name                string             `json:"name"`
+	Status              string             `json:"status"`
+	StatusReason        string             `json:"status_reason,omitempty"`
+	ClusterTemplateID   string             `json:"cluster_template_id"`
+	ClusterTemplateName string             `json:"cluster_template_name,omitempty"`
+	COEVersion          string             `json:"coe_version,omitempty"`
+	APIAddress          string             `json:"api_address,omitempty"`
+	NodeCount           int                `json:"node_count"`
+	MasterCount         int                `json:"master_count"`
+	KeyPair             string             `json:"keypair"`
+	NodeGroups          []ClusterNodeGroup `json:"node_groups,omitempty"`
+	CreatedAt           time.Time          `json:"created_at"`
+	UpdatedAt           time.Time          `json:"updated_at"`
+}
+
+// ClusterNodeGroup represents Magnum cluster node group
+type ClusterNodeGroup struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Role         string `json:"role"`
+	Status       string `json:"status"`
+	FlavorID     string `json:"flavor_id,omitempty"`
+	NodeCount    int    `json:"node_count"`
+	MinNodeCount int    `json:"min_node_count"`
+	MaxNodeCount *int   `json:"max_node_count,omitempty"`
+	IsDefault    bool   `json:"is_default"`
+}
+
+// Image represents Glance image
+type Image struct {
+	ID              string        `json:"id"`
+	Name            string        `json:"name"`
+	Status          string        `json:"status"`
+	Visibility      string        `json:"visibility"`
+	Protected       bool          `json:"protected"`
+	SizeBytes       int64         `json:"size_bytes"`
+	DiskFormat      string        `json:"disk_format"`
+	ContainerFormat string        `json:"container_format"`
+	OSType          string        `json:"os_type,omitempty"`
+	OSDistro        string        `json:"os_distro,omitempty"`
+	OSVersion       string        `json:"os_version,omitempty"`
+	Architecture    string        `json:"architecture,omitempty"`
+	OwnerID         string        `json:"owner_id"`
+	Servers         []ImageServer `json:"servers"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+}
+
+// ImageServer represents a server booted from an image
+type ImageServer struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ProjectName string `json:"project_name"`
+}
+
+// SecurityGroup represents Neutron security group
+type SecurityGroup struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	Rules       []SecurityGroupRule   `json:"rules"`
+	Ports       []SecurityGroupPort   `json:"ports"`
+	Servers     []SecurityGroupServer `json:"servers"`
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+// SecurityGroupRule represents a single security group rule
+type SecurityGroupRule struct {
+	ID             string `json:"id"`
+	Direction      string `json:"direction"`
+	EtherType      string `json:"ethertype"`
+	Protocol       string `json:"protocol,omitempty"`
+	PortRangeMin   int    `json:"port_range_min,omitempty"`
+	PortRangeMax   int    `json:"port_range_max,omitempty"`
+	RemoteIPPrefix string `json:"remote_ip_prefix,omitempty"`
+	RemoteGroupID  string `json:"remote_group_id,omitempty"`
+	Description    string `json:"description,omitempty"`
+}
+
+// SecurityGroupPort represents a port a security group is applied to
+type SecurityGroupPort struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name,omitempty"`
+	DeviceOwner string   `json:"device_owner,omitempty"`
+	DeviceID    string   `json:"device_id,omitempty"`
+	FixedIPs    []string `json:"fixed_ips,omitempty"`
+}
+
+// SecurityGroupServer represents a server using a security group through one of its ports
+type SecurityGroupServer struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Port represents Neutron port. OrphanReasons is set for ports that are down,
+// have no device or belong to a deleted server or load balancer.
+type Port struct {
+	ID             string        `json:"id"`
+	Name           string        `json:"name"`
+	Status         string        `json:"status"`
+	AdminStateUp   bool          `json:"admin_state_up"`
+	NetworkID      string        `json:"network_id"`
+	NetworkName    string        `json:"network_name,omitempty"`
+	MACAddress     string        `json:"mac_address"`
+	DeviceOwner    string        `json:"device_owner,omitempty"`
+	DeviceID       string        `json:"device_id,omitempty"`
+	DeviceName     string        `json:"device_name,omitempty"`
+	FixedIPs       []PortFixedIP `json:"fixed_ips"`
+	SecurityGroups []string      `json:"security_groups"`
+	OrphanReasons  []string      `json:"orphan_reasons,omitempty"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+// PortFixedIP represents an address of a port
+type PortFixedIP struct {
+	SubnetID  string `json:"subnet_id"`
+	IPAddress string `json:"ip_address"`
+}
+
+// ObjectContainer represents Swift container. Public is set when the read ACL
+// grants anonymous access (".r:*").
+type ObjectContainer struct {
+	Name          string   `json:"name"`
+	ObjectCount   int64    `json:"object_count"`
+	BytesUsed     int64    `json:"bytes_used"`
+	Public        bool     `json:"public"`
+	ReadACL       []string `json:"read_acl,omitempty"`
+	WriteACL      []string `json:"write_acl,omitempty"`
+	StoragePolicy string   `json:"storage_policy,omitempty"`
+}
+
+// ObjectAccount represents the stats of a project's Swift account in one region, including
+// containers the listing doesn't return
+type ObjectAccount struct {
+	Name           string `json:"name"`
+	ContainerCount int64  `json:"container_count"`
+	ObjectCount    int64  `json:"object_count"`
+	BytesUsed      int64  `json:"bytes_used"`
+	QuotaBytes     *int64 `json:"quota_bytes,omitempty"`
+}
+
+// DNSZone represents Designate zone
+type DNSZone struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Email       string    `json:"email,omitempty"`
+	Type        string    `json:"type"`
+	Status      string    `json:"status"`
+	TTL         int       `json:"ttl"`
+	Serial      int       `json:"serial"`
+	Masters     []string  `json:"masters,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Recordsets  int       `json:"recordsets"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// DNSRecordSet represents Designate recordset. UnallocatedAddresses lists the addresses of
+// A, AAAA and PTR records that lie in a cloud subnet but belong to no port or floating IP.
+type DNSRecordSet struct {
+	ID                   string    `json:"id"`
+	Name                 string    `json:"name"`
+	ZoneID               string    `json:"zone_id"`
+	ZoneName             string    `json:"zone_name"`
+	Type                 string    `json:"type"`
+	Records              []string  `json:"records"`
+	TTL                  int       `json:"ttl,omitempty"`
+	Status               string    `json:"status"`
+	Description          string    `json:"description,omitempty"`
+	UnallocatedAddresses []string  `json:"unallocated_addresses,omitempty"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+// Stack represents Heat stack with the resources it manages
+type Stack struct {
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	Status       string          `json:"status"`
+	StatusReason string          `json:"status_reason,omitempty"`
+	Description  string          `json:"description,omitempty"`
+	Tags         []string        `json:"tags,omitempty"`
+	ParentID     string          `json:"parent_id,omitempty"`   // parent of a nested stack
+	ParentName   string          `json:"parent_name,omitempty"` // set when the parent is listed too
+	Resources    []StackResource `json:"resources"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// StackResource represents a resource of a Heat stack, PhysicalID is the ID of the
+// OpenStack resource it created
+type StackResource struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	PhysicalID string `json:"physical_id,omitempty"`
+	Status     string `json:"status"`
 }
 
 // Router represents OpenStack network router
@@ -134,35 +450,257 @@ type Subnet struct {
 
 // Network represents OpenStack network
 type Network struct {
-	ID           string    `json:"id"`
-	Name         string    `json:"name"`
-	Status       string    `json:"status"`
-	AdminStateUp bool      `json:"admin_state_up"`
-	Shared       bool      `json:"shared"`
-	External     bool      `json:"external"`
-	NetworkType  string    `json:"network_type"`
-	Subnets      []Subnet  `json:"subnets"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID                  string    `json:"id"`
+	Name                string    `json:"name"`
+	Status              string    `json:"status"`
+	AdminStateUp        bool      `json:"admin_state_up"`
+	Shared              bool      `json:"shared"`
+	External            bool      `json:"external"`
+	NetworkType         string    `json:"network_type"`
+	PhysicalNetwork     string    `json:"physical_network,omitempty"`
+	SegmentationID      int       `json:"segmentation_id,omitempty"`
+	MTU                 int       `json:"mtu,omitempty"`
+	PortSecurityEnabled *bool     `json:"port_security_enabled,omitempty"`
+	AvailabilityZones   []string  `json:"availability_zones,omitempty"`
+	Subnets             []Subnet  `json:"subnets"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// ProjectQuota represents quota limits and usage of a project in one region
+type ProjectQuota struct {
+	ProjectID   string       `json:"project_id"`
+	ProjectName string       `json:"project_name"`
+	Cloud       string       `json:"cloud,omitempty"`
+	Region      string       `json:"region,omitempty"`
+	Usages      []QuotaUsage `json:"usages"`
+}
+
+// QuotaUsage represents limit and usage of a single quota, a negative limit means unlimited
+type QuotaUsage struct {
+	Service  string `json:"service"`  // compute, volume or network
+	Resource string `json:"resource"` // e.g. cores, gigabytes, floatingip
+	Limit    int    `json:"limit"`
+	InUse    int    `json:"in_use"`
+	Reserved int    `json:"reserved"`
+}
+
+// Unlimited reports whether the quota has no limit
+func (q QuotaUsage) Unlimited() bool {
+	return q.Limit < 0
+}
+
+// PercentUsed returns the used share of the limit in percent, 0 for unlimited quotas
+func (q QuotaUsage) PercentUsed() float64 {
+	if q.Unlimited() {
+		return 0
+	}
+	if q.Limit == 0 {
+		if q.InUse > 0 {
+			return 100
+		}
+		return 0
+	}
+	return float64(q.InUse) * 100 / float64(q.Limit)
+}
+
+// RegionCapacity represents compute capacity of a region, visible only to admins
+type RegionCapacity struct {
+	Cloud             string             `json:"cloud,omitempty"`
+	Region            string             `json:"region,omitempty"`
+	Totals            CapacityUsage      `json:"totals"`
+	Hypervisors       []Hypervisor       `json:"hypervisors"`
+	Aggregates        []Aggregate        `json:"aggregates"`
+	AvailabilityZones []AvailabilityZone `json:"availability_zones"`
+}
+
+// CapacityUsage represents total and used compute resources
+type CapacityUsage struct {
+	VCPUs        int `json:"vcpus"`
+	VCPUsUsed    int `json:"vcpus_used"`
+	MemoryMB     int `json:"memory_mb"`
+	MemoryMBUsed int `json:"memory_mb_used"`
+	LocalGB      int `json:"local_gb"`
+	LocalGBUsed  int `json:"local_gb_used"`
+	RunningVMs   int `json:"running_vms"`
+}
+
+// Add sums other into the usage
+func (u *CapacityUsage) Add(other CapacityUsage) {
+	u.VCPUs += other.VCPUs
+	u.VCPUsUsed += other.VCPUsUsed
+	u.MemoryMB += other.MemoryMB
+	u.MemoryMBUsed += other.MemoryMBUsed
+	u.LocalGB += other.LocalGB
+	u.LocalGBUsed += other.LocalGBUsed
+	u.RunningVMs += other.RunningVMs
+}
+
+// Hypervisor represents Nova hypervisor with the servers placed on its host
+type Hypervisor struct {
+	ID               string             `json:"id"`
+	Hostname         string             `json:"hypervisor_hostname"`
+	Host             string             `json:"host"`
+	Type             string             `json:"hypervisor_type"`
+	HostIP           string             `json:"host_ip,omitempty"`
+	State            string             `json:"state"`
+	Status           string             `json:"status"`
+	DisabledReason   string             `json:"disabled_reason,omitempty"`
+	AvailabilityZone string             `json:"availability_zone,omitempty"`
+	Aggregates       []string           `json:"aggregates,omitempty"`
+	Usage            CapacityUsage      `json:"usage"`
+	Projects         []HostProjectUsage `json:"projects"`
+}
+
+// HostProjectUsage represents the servers of one project placed on a host
+type HostProjectUsage struct {
+	ProjectID   string `json:"project_id"`
+	ProjectName string `json:"project_name"`
+	Servers     int    `json:"servers"`
+	VCPUs       int    `json:"vcpus"`
+	RAMMB       int    `json:"ram_mb"`
+}
+
+// Aggregate represents Nova host aggregate
+type Aggregate struct {
+	ID               int               `json:"id"`
+	Name             string            `json:"name"`
+	AvailabilityZone string            `json:"availability_zone,omitempty"`
+	Hosts            []string          `json:"hosts"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+	Usage            CapacityUsage     `json:"usage"`
+}
+
+// AvailabilityZone represents Nova availability zone with its compute hosts
+type AvailabilityZone struct {
+	Name      string        `json:"name"`
+	Available bool          `json:"available"`
+	Hosts     []string      `json:"hosts"`
+	Usage     CapacityUsage `json:"usage"`
+}
+
+// ProjectAccess lists the users and groups holding roles on a project
+type ProjectAccess struct {
+	ProjectID   string          `json:"project_id"`
+	ProjectName string          `json:"project_name"`
+	Cloud       string          `json:"cloud,omitempty"`
+	Members     []ProjectMember `json:"members"`
+}
+
+// ProjectMember is a user or group with its roles on a project. Users lists the members of a group.
+type ProjectMember struct {
+	Type    string       `json:"type"`
+	ID      string       `json:"id"`
+	Name    string       `json:"name"`
+	Domain  string       `json:"domain,omitempty"`
+	Roles   []string     `json:"roles"`
+	Enabled *bool        `json:"enabled,omitempty"`
+	Users   []AccessUser `json:"users,omitempty"`
+}
+
+// AccessUser represents Keystone user inherited through a group
+type AccessUser struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	DomainID string `json:"domain_id,omitempty"`
+	Enabled  bool   `json:"enabled"`
 }
 
 // ResourceReport represents the complete report structure
 type ResourceReport struct {
-	GeneratedAt time.Time  `json:"generated_at"`
-	Projects    []Project  `json:"projects"`
-	Resources   []Resource `json:"resources"`
-	Summary     Summary    `json:"summary"`
+	GeneratedAt time.Time        `json:"generated_at"`
+	Projects    []Project        `json:"projects"`
+	Resources   []Resource       `json:"resources"`
+	Quotas      []ProjectQuota   `json:"quotas,omitempty"`
+	Capacity    []RegionCapacity `json:"capacity,omitempty"`
+	Access      []ProjectAccess  `json:"access,omitempty"`
+	Summary     Summary          `json:"summary"`
 }
 
 // Summary provides counts by resource type
 type Summary struct {
-	TotalProjects      int `json:"total_projects"`
-	TotalServers       int `json:"total_servers"`
-	TotalVolumes       int `json:"total_volumes"`
-	TotalLoadBalancers int `json:"total_load_balancers"`
-	TotalFloatingIPs   int `json:"total_floating_ips"`
-	TotalVPNServices   int `json:"total_vpn_services"`
-	TotalClusters      int `json:"total_clusters"`
-	TotalRouters       int `json:"total_routers"`
-	TotalNetworks      int `json:"total_networks"`
+	TotalProjects        int `json:"total_projects"`
+	TotalServers         int `json:"total_servers"`
+	TotalVolumes         int `json:"total_volumes"`
+	TotalLoadBalancers   int `json:"total_load_balancers"`
+	TotalFloatingIPs     int `json:"total_floating_ips"`
+	TotalVPNServices     int `json:"total_vpn_services"`
+	TotalClusters        int `json:"total_clusters"`
+	TotalRouters         int `json:"total_routers"`
+	TotalNetworks        int `json:"total_networks"`
+	TotalImages          int `json:"total_images"`
+	TotalSecurityGroups  int `json:"total_security_groups"`
+	TotalVolumeSnapshots int `json:"total_volume_snapshots"`
+	TotalVolumeBackups   int `json:"total_volume_backups"`
+	TotalPorts           int `json:"total_ports"`
+	TotalContainers      int `json:"total_containers"`
+	TotalDNSZones        int `json:"total_dns_zones"`
+	TotalDNSRecordsets   int `json:"total_dns_recordsets"`
+	TotalStacks          int `json:"total_stacks"`
+
+	// ObjectStorage totals Swift containers and accounts per project
+	ObjectStorage []ProjectObjectStorage `json:"object_storage,omitempty"`
+
+	// ByRegion counts resources per region and type, empty when no region is configured
+	ByRegion map[string]map[string]int `json:"by_region,omitempty"`
+}
+
+// ProjectObjectStorage sums the Swift containers of one project
+type ProjectObjectStorage struct {
+	ProjectID        string `json:"project_id"`
+	ProjectName      string `json:"project_name"`
+	Cloud            string `json:"cloud,omitempty"`
+	Containers       int    `json:"containers"`
+	PublicContainers int    `json:"public_containers"`
+	Objects          int64  `json:"objects"`
+	BytesUsed        int64  `json:"bytes_used"`
+
+	// Account totals sum the Swift account stats of the project over all regions
+	AccountContainers int64 `json:"account_containers,omitempty"`
+	AccountObjects    int64 `json:"account_objects,omitempty"`
+	AccountBytesUsed  int64 `json:"account_bytes_used,omitempty"`
+}
+
+// AddContainer counts a container resource towards its project's object storage totals
+func (s *Summary) AddContainer(resource Resource) {
+	var container ObjectContainer
+	if !DecodeProperties(resource.Properties, &container) {
+		return
+	}
+
+	totals := s.projectObjectStorage(resource)
+	totals.Containers++
+	if container.Public {
+		totals.PublicContainers++
+	}
+	totals.Objects += container.ObjectCount
+	totals.BytesUsed += container.BytesUsed
+}
+
+// AddObjectAccount counts an object_account resource towards its project's object storage totals
+func (s *Summary) AddObjectAccount(resource Resource) {
+	var account ObjectAccount
+	if !DecodeProperties(resource.Properties, &account) {
+		return
+	}
+
+	totals := s.projectObjectStorage(resource)
+	totals.AccountContainers += account.ContainerCount
+	totals.AccountObjects += account.ObjectCount
+	totals.AccountBytesUsed += account.BytesUsed
+}
+
+// projectObjectStorage returns the object storage totals of a resource's project, adding them if missing
+func (s *Summary) projectObjectStorage(resource Resource) *ProjectObjectStorage {
+	for i := range s.ObjectStorage {
+		if s.ObjectStorage[i].ProjectID == resource.ProjectID && s.ObjectStorage[i].Cloud == resource.Cloud {
+			return &s.ObjectStorage[i]
+		}
+	}
+	s.ObjectStorage = append(s.ObjectStorage, ProjectObjectStorage{
+		ProjectID:   resource.ProjectID,
+		ProjectName: resource.ProjectName,
+		Cloud:       resource.Cloud,
+	})
+	return &s.ObjectStorage[len(s.ObjectStorage)-1]
 }
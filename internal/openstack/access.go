@@ -0,0 +1,180 @@
+package openstack
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
+
+	"openstack-reporter/internal/models"
+)
+
+// collectAccess lists the role assignments of every project with the users of assigned groups.
+// Like project listing it uses a domain-scoped token, as some clouds only let those list role
+// assignments, and falls back to the client's own token when that lists nothing.
+func (c *Client) collectAccess(projectList []models.Project) []models.ProjectAccess {
+	domainClient, err := c.createDomainScopedClient()
+	if err != nil {
+		fmt.Printf("DEBUG: Domain-scoped client for role assignments failed, using project token: %v\n", err)
+		return c.listProjectAccess(projectList)
+	}
+	if access := domainClient.listProjectAccess(projectList); access != nil {
+		return access
+	}
+	fmt.Printf("DEBUG: No role assignments listed with the domain-scoped token, using project token\n")
+	return c.listProjectAccess(projectList)
+}
+
+// listProjectAccess lists the role assignments of every project through the client's identity
+// endpoint. All assignments are listed at once with admin credentials, otherwise each project
+// is asked for separately, which project admins are usually allowed to do. Projects whose
+// assignments can't be listed are left out, nil is returned when none could be listed.
+func (c *Client) listProjectAccess(projectList []models.Project) []models.ProjectAccess {
+	includeNames := true
+	assignmentsByProject := make(map[string][]roles.RoleAssignment)
+
+	assignments, err := c.listRoleAssignments(roles.ListAssignmentsOpts{IncludeNames: &includeNames})
+	if err == nil {
+		for _, assignment := range assignments {
+			projectID := assignment.Scope.Project.ID
+			if projectID != "" {
+				assignmentsByProject[projectID] = append(assignmentsByProject[projectID], assignment)
+			}
+		}
+	} else {
+		fmt.Printf("DEBUG: Listing all role assignments failed, listing per project: %v\n", err)
+		for _, project := range projectList {
+			projectAssignments, err := c.listRoleAssignments(roles.ListAssignmentsOpts{ScopeProjectID: project.ID, IncludeNames: &includeNames})
+			if err != nil {
+				fmt.Printf("DEBUG: Role assignments of project %s not collected: %v\n", project.Name, err)
+				continue
+			}
+			assignmentsByProject[project.ID] = projectAssignments
+		}
+	}
+	if len(assignmentsByProject) == 0 {
+		return nil
+	}
+
+	userStates := c.getUserStates()
+	groupUsers := make(map[string][]models.AccessUser)
+
+	var access []models.ProjectAccess
+	for _, project := range projectList {
+		projectAssignments, exists := assignmentsByProject[project.ID]
+		if !exists {
+			continue
+		}
+
+		memberIndex := make(map[string]int)
+		projectAccess := models.ProjectAccess{
+			ProjectID:   project.ID,
+			ProjectName: project.Name,
+			Members:     []models.ProjectMember{},
+		}
+		for _, assignment := range projectAssignments {
+			member := models.ProjectMember{Roles: []string{}}
+			switch {
+			case assignment.User.ID != "":
+				member.Type = "user"
+				member.ID = assignment.User.ID
+				member.Name = assignment.User.Name
+				member.Domain = assignment.User.Domain.Name
+				if enabled, known := userStates[member.ID]; known {
+					member.Enabled = &enabled
+				}
+			case assignment.Group.ID != "":
+				member.Type = "group"
+				member.ID = assignment.Group.ID
+				member.Name = assignment.Group.Name
+				member.Domain = assignment.Group.Domain.Name
+				if _, listed := groupUsers[member.ID]; !listed {
+					groupUsers[member.ID] = c.getGroupUsers(member.ID)
+				}
+				member.Users = groupUsers[member.ID]
+			default:
+				continue
+			}
+
+			key := member.Type + ":" + member.ID
+			i, exists := memberIndex[key]
+			if !exists {
+				i = len(projectAccess.Members)
+				memberIndex[key] = i
+				projectAccess.Members = append(projectAccess.Members, member)
+			}
+			roleName := assignment.Role.Name
+			if roleName == "" {
+				roleName = assignment.Role.ID
+			}
+			projectAccess.Members[i].Roles = append(projectAccess.Members[i].Roles, roleName)
+		}
+
+		sort.Slice(projectAccess.Members, func(i, j int) bool {
+			if projectAccess.Members[i].Type != projectAccess.Members[j].Type {
+				return projectAccess.Members[i].Type > projectAccess.Members[j].Type // users before groups
+			}
+			return projectAccess.Members[i].Name < projectAccess.Members[j].Name
+		})
+		access = append(access, projectAccess)
+	}
+
+	return access
+}
+
+// listRoleAssignments lists role assignments matching opts
+func (c *Client) listRoleAssignments(opts roles.ListAssignmentsOpts) ([]roles.RoleAssignment, error) {
+	allPages, err := roles.ListAssignments(c.identityClient, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return roles.ExtractRoleAssignments(allPages)
+}
+
+// getUserStates maps user IDs to whether the user is enabled. Listing users requires admin
+// credentials, the map is empty otherwise.
+func (c *Client) getUserStates() map[string]bool {
+	states := make(map[string]bool)
+	allPages, err := users.List(c.identityClient, users.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to list users: %v\n", err)
+		return states
+	}
+	userList, err := users.ExtractUsers(allPages)
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to extract users: %v\n", err)
+		return states
+	}
+	for _, user := range userList {
+		states[user.ID] = user.Enabled
+	}
+	return states
+}
+
+// getGroupUsers lists the users of a group, empty when they can't be listed
+func (c *Client) getGroupUsers(groupID string) []models.AccessUser {
+	groupUsers := []models.AccessUser{}
+	allPages, err := users.ListInGroup(c.identityClient, groupID, users.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to list users of group %s: %v\n", groupID, err)
+		return groupUsers
+	}
+	userList, err := users.ExtractUsers(allPages)
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to extract users of group %s: %v\n", groupID, err)
+		return groupUsers
+	}
+	for _, user := range userList {
+		groupUsers = append(groupUsers, models.AccessUser{
+			ID:       user.ID,
+			Name:     user.Name,
+			DomainID: user.DomainID,
+			Enabled:  user.Enabled,
+		})
+	}
+	sort.Slice(groupUsers, func(i, j int) bool {
+		return groupUsers[i].Name < groupUsers[j].Name
+	})
+	return groupUsers
+}
@@ -0,0 +1,208 @@
+package openstack
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+const (
+	authTypePassword              = "password"
+	authTypeApplicationCredential = "v3applicationcredential"
+)
+
+// authType returns the Keystone auth method selected by auth_type / OS_AUTH_TYPE.
+// Application credentials are also used when it is unset but a credential ID or name is given.
+func (cfg *cloudConfig) authType() string {
+	switch strings.ToLower(strings.TrimSpace(cfg.AuthType)) {
+	case authTypeApplicationCredential:
+		return authTypeApplicationCredential
+	case "":
+		if cfg.ApplicationCredentialID != "" || cfg.ApplicationCredentialName != "" {
+			return authTypeApplicationCredential
+		}
+		return authTypePassword
+	case authTypePassword, "v3password":
+		return authTypePassword
+	default:
+		fmt.Printf("DEBUG: Unsupported auth type %q, falling back to password authentication\n", cfg.AuthType)
+		return authTypePassword
+	}
+}
+
+// usesApplicationCredential reports whether clients authenticate with a Keystone application credential
+func (cfg *cloudConfig) usesApplicationCredential() bool {
+	return cfg.authType() == authTypeApplicationCredential
+}
+
+// describeAuth returns a human readable description of the auth method
+func (cfg *cloudConfig) describeAuth() string {
+	source := "environment"
+	if cfg.Name != "" {
+		source = fmt.Sprintf("cloud %q", cfg.Name)
+	}
+
+	if cfg.usesApplicationCredential() {
+		if cfg.ApplicationCredentialID != "" {
+			return fmt.Sprintf("application credential (id %s) from %s", cfg.ApplicationCredentialID, source)
+		}
+		return fmt.Sprintf("application credential (name %s, user %s) from %s",
+			cfg.ApplicationCredentialName, cfg.Username, source)
+	}
+	return fmt.Sprintf("password (user %s, domain %s) from %s", cfg.Username, cfg.UserDomainName, source)
+}
+
+// DescribeAuth returns a human readable description of the configured auth method for startup logs
+func DescribeAuth() string {
+	if cloudNames := CloudNames(); len(cloudNames) > 0 {
+		descriptions := make([]string, 0, len(cloudNames))
+		for _, cloudName := range cloudNames {
+			cfg, err := cloudConfigFromFile(cloudName)
+			if err != nil {
+				descriptions = append(descriptions, fmt.Sprintf("cloud %q: invalid configuration: %v", cloudName, err))
+				continue
+			}
+			descriptions = append(descriptions, cfg.describeAuth())
+		}
+		return strings.Join(descriptions, "; ")
+	}
+
+	cfg, err := loadCloudConfig()
+	if err != nil {
+		return fmt.Sprintf("invalid configuration: %v", err)
+	}
+	return cfg.describeAuth()
+}
+
+// baseAuthOptions builds credentials without any project or domain scope
+func (cfg *cloudConfig) baseAuthOptions() (gophercloud.AuthOptions, error) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: cfg.AuthURL,
+	}
+
+	if !cfg.usesApplicationCredential() {
+		opts.Username = cfg.Username
+		opts.UserID = cfg.UserID
+		opts.Password = cfg.Password
+		opts.DomainName = cfg.UserDomainName
+		opts.DomainID = cfg.UserDomainID
+		return opts, nil
+	}
+
+	opts.ApplicationCredentialID = cfg.ApplicationCredentialID
+	opts.ApplicationCredentialName = cfg.ApplicationCredentialName
+	opts.ApplicationCredentialSecret = cfg.ApplicationCredentialSecret
+
+	if opts.ApplicationCredentialSecret == "" {
+		return opts, fmt.Errorf("application credential secret is required for application credential authentication")
+	}
+	if opts.ApplicationCredentialID == "" {
+		if opts.ApplicationCredentialName == "" {
+			return opts, fmt.Errorf("application credential ID or name is required for application credential authentication")
+		}
+		// A credential name is only unique per user, so the owner has to be identified
+		opts.Username = cfg.Username
+		opts.UserID = cfg.UserID
+		opts.DomainName = cfg.UserDomainName
+		opts.DomainID = cfg.UserDomainID
+	}
+
+	return opts, nil
+}
+
+// projectScope returns the Keystone scope of a project, by ID when it is known. Projects
+// given by name live in the user's domain unless a project domain is configured.
+func (cfg *cloudConfig) projectScope(projectID, projectName string) *gophercloud.AuthScope {
+	if projectID != "" {
+		return &gophercloud.AuthScope{ProjectID: projectID}
+	}
+	scope := &gophercloud.AuthScope{
+		ProjectName: projectName,
+		DomainName:  firstNonEmpty(cfg.ProjectDomainName, cfg.UserDomainName),
+	}
+	if cfg.ProjectDomainName == "" && cfg.ProjectDomainID != "" {
+		scope.DomainName = ""
+		scope.DomainID = cfg.ProjectDomainID
+	}
+	return scope
+}
+
+// domainScope returns the scope of the user's domain, used for project listing
+func (cfg *cloudConfig) domainScope() *gophercloud.AuthScope {
+	scope := &gophercloud.AuthScope{
+		DomainName: cfg.UserDomainName,
+	}
+	if cfg.UserDomainName == "" {
+		scope.DomainID = cfg.UserDomainID
+	}
+	return scope
+}
+
+// newProvider creates an unauthenticated provider client with the cloud's TLS settings,
+// retries and rate limit whose requests are bound to ctx
+func (cfg *cloudConfig) newProvider(ctx context.Context) (*gophercloud.ProviderClient, error) {
+	provider, err := openstack.NewClient(cfg.AuthURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create provider client: %w", err)
+	}
+
+	var base http.RoundTripper = http.DefaultTransport
+
+	// Handle insecure connections and custom CA bundles
+	if cfg.Insecure || cfg.CACert != "" {
+		tlsConfig := &tls.Config{InsecureSkipVerify: cfg.Insecure}
+		if cfg.CACert != "" && !cfg.Insecure {
+			caPEM, err := os.ReadFile(cfg.CACert)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read CA certificate %s: %w", cfg.CACert, err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caPEM) {
+				return nil, fmt.Errorf("no certificates found in %s", cfg.CACert)
+			}
+			tlsConfig.RootCAs = pool
+		}
+		base = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+	provider.HTTPClient = http.Client{Transport: cfg.newRetryTransport(base)}
+	provider.Context = withServiceTimeout(ctx, "identity")
+
+	return provider, nil
+}
+
+// authenticate creates a provider client for the cloud and authenticates it with opts
+func (cfg *cloudConfig) authenticate(ctx context.Context, opts gophercloud.AuthOptions) (*gophercloud.ProviderClient, error) {
+	provider, err := cfg.newProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := openstack.Authenticate(provider, opts); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// tokenProject extracts the project the provider's token is scoped to
+func tokenProject(provider *gophercloud.ProviderClient) (*tokens.Project, error) {
+	result, ok := provider.GetAuthResult().(tokens.CreateResult)
+	if !ok {
+		return nil, fmt.Errorf("no v3 authentication result available")
+	}
+	project, err := result.ExtractProject()
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, fmt.Errorf("token is not project-scoped")
+	}
+	return project, nil
+}
@@ -0,0 +1,404 @@
+package openstack
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+)
+
+// lookupCache keeps flavor, server, volume and port data for the duration of one collection run.
+// It is filled from bulk listings so collectors don't issue a GET per server, volume
+// attachment or floating IP. A single cache is shared by the main client and all
+// project-scoped clients of a run; listings are done once per scope and endpoint.
+type lookupCache struct {
+	mu          sync.RWMutex
+	flavors     map[string]flavors.Flavor // flavor ID -> flavor
+	serverNames map[string]string         // server ID -> name
+	volumeNames map[string]string         // volume ID -> name, only for existing volumes
+	ports       map[string]ports.Port     // port ID -> port
+	portLists   map[string][]ports.Port   // port listings, keyed by scope and endpoint
+	lbNames     map[string]string         // load balancer ID -> name
+	loads       map[string]*sync.Once     // bulk listings, keyed by kind, scope and endpoint
+	listed      map[string]bool           // bulk listings that succeeded, so missing items no longer exist
+	claimed     map[string]bool           // resources already reported by a client of the run
+
+	bulkCalls   int64
+	singleCalls int64
+	hits        int64
+}
+
+func newLookupCache() *lookupCache {
+	return &lookupCache{
+		flavors:     make(map[string]flavors.Flavor),
+		serverNames: make(map[string]string),
+		volumeNames: make(map[string]string),
+		ports:       make(map[string]ports.Port),
+		portLists:   make(map[string][]ports.Port),
+		lbNames:     make(map[string]string),
+		loads:       make(map[string]*sync.Once),
+		listed:      make(map[string]bool),
+		claimed:     make(map[string]bool),
+	}
+}
+
+// loadOnce runs load only the first time it is called for key
+func (lc *lookupCache) loadOnce(key string, load func()) {
+	lc.mu.Lock()
+	once, exists := lc.loads[key]
+	if !exists {
+		once = &sync.Once{}
+		lc.loads[key] = once
+	}
+	lc.mu.Unlock()
+
+	once.Do(func() {
+		atomic.AddInt64(&lc.bulkCalls, 1)
+		load()
+	})
+}
+
+// markListed records that the bulk listing of key succeeded
+func (lc *lookupCache) markListed(key string) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.listed[key] = true
+}
+
+// isListed reports whether the bulk listing of key succeeded
+func (lc *lookupCache) isListed(key string) bool {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	return lc.listed[key]
+}
+
+// claim reports whether key is claimed for the first time, so a resource visible to several
+// clients of a run is reported only once
+func (lc *lookupCache) claim(key string) bool {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if lc.claimed[key] {
+		return false
+	}
+	lc.claimed[key] = true
+	return true
+}
+
+// Stats returns a short description of cache efficiency for logging
+func (lc *lookupCache) Stats() string {
+	return fmt.Sprintf("%d bulk listings, %d single lookups, %d cache hits",
+		atomic.LoadInt64(&lc.bulkCalls), atomic.LoadInt64(&lc.singleCalls), atomic.LoadInt64(&lc.hits))
+}
+
+func (lc *lookupCache) getFlavor(id string) (flavors.Flavor, bool) {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	flavor, ok := lc.flavors[id]
+	return flavor, ok
+}
+
+func (lc *lookupCache) getServerName(id string) (string, bool) {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	name, ok := lc.serverNames[id]
+	return name, ok
+}
+
+func (lc *lookupCache) getVolumeName(id string) (string, bool) {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	name, ok := lc.volumeNames[id]
+	return name, ok
+}
+
+func (lc *lookupCache) getLoadBalancerName(id string) (string, bool) {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	name, ok := lc.lbNames[id]
+	return name, ok
+}
+
+func (lc *lookupCache) getPort(id string) (ports.Port, bool) {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	port, ok := lc.ports[id]
+	return port, ok
+}
+
+// addServers records server names, e.g. from a listing done by the servers collector
+func (lc *lookupCache) addServers(serverList []servers.Server) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	for _, server := range serverList {
+		lc.serverNames[server.ID] = server.Name
+	}
+}
+
+// addPorts records ports, e.g. from a listing done by the ports collector
+func (lc *lookupCache) addPorts(portList []ports.Port) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	for _, port := range portList {
+		lc.ports[port.ID] = port
+	}
+}
+
+// cacheScope identifies the token scope of a client so listings are shared only between equal scopes
+func (c *Client) cacheScope(kind, endpoint string) string {
+	return kind + "|" + c.scope + "|" + endpoint
+}
+
+// seedServers marks the server listing of the client's scope as done, using a listing made by a collector.
+// complete is false when the listing covers less than the scope, e.g. after an all-tenants listing failed.
+func (c *Client) seedServers(serverList []servers.Server, complete bool) {
+	key := c.cacheScope("servers", c.computeClient.Endpoint)
+	c.cache.loadOnce(key, func() {
+		c.cache.addServers(serverList)
+		if complete {
+			c.cache.markListed(key)
+		}
+	})
+}
+
+// loadServers lists all servers visible to the client once per run. It reports whether the
+// listing covered the whole scope, so a server missing from the cache no longer exists.
+func (c *Client) loadServers() bool {
+	key := c.cacheScope("servers", c.computeClient.Endpoint)
+	c.cache.loadOnce(key, func() {
+		listOpts := servers.ListOpts{AllTenants: c.allTenants()}
+		allPages, err := servers.List(c.computeClient, listOpts).AllPages()
+		complete := true
+		if err != nil && listOpts.AllTenants {
+			// Fallback to current tenant only if AllTenants fails. Servers of other projects
+			// are then missing, so the listing doesn't prove a server was deleted.
+			allPages, err = servers.List(c.computeClient, servers.ListOpts{}).AllPages()
+			complete = false
+		}
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to list servers for cache: %v\n", err)
+			return
+		}
+		serverList, err := servers.ExtractServers(allPages)
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to extract servers for cache: %v\n", err)
+			return
+		}
+		c.cache.addServers(serverList)
+		if complete {
+			c.cache.markListed(key)
+		}
+	})
+	return c.cache.isListed(key)
+}
+
+// loadLoadBalancers lists all load balancers visible to the client once per run. It reports
+// whether the listing succeeded, so a load balancer missing from the cache no longer exists.
+func (c *Client) loadLoadBalancers() bool {
+	key := c.cacheScope("load_balancers", c.loadbalancerClient.Endpoint)
+	c.cache.loadOnce(key, func() {
+		allPages, err := loadbalancers.List(c.loadbalancerClient, loadbalancers.ListOpts{}).AllPages()
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to list load balancers for cache: %v\n", err)
+			return
+		}
+		lbList, err := loadbalancers.ExtractLoadBalancers(allPages)
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to extract load balancers for cache: %v\n", err)
+			return
+		}
+		c.cache.mu.Lock()
+		for _, lb := range lbList {
+			c.cache.lbNames[lb.ID] = lb.Name
+		}
+		c.cache.mu.Unlock()
+		c.cache.markListed(key)
+	})
+	return c.cache.isListed(key)
+}
+
+// lookupFlavorName returns the flavor name, listing all flavors visible to the client on first use
+func (c *Client) lookupFlavorName(flavorID string) (string, error) {
+	flavor, err := c.lookupFlavor(flavorID)
+	if err != nil {
+		return "", err
+	}
+	return flavor.Name, nil
+}
+
+// lookupFlavor returns the flavor, listing all flavors visible to the client on first use
+func (c *Client) lookupFlavor(flavorID string) (flavors.Flavor, error) {
+	c.cache.loadOnce(c.cacheScope("flavors", c.computeClient.Endpoint), func() {
+		allPages, err := flavors.ListDetail(c.computeClient, flavors.ListOpts{}).AllPages()
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to list flavors for cache: %v\n", err)
+			return
+		}
+		flavorList, err := flavors.ExtractFlavors(allPages)
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to extract flavors for cache: %v\n", err)
+			return
+		}
+		c.cache.mu.Lock()
+		for _, flavor := range flavorList {
+			c.cache.flavors[flavor.ID] = flavor
+		}
+		c.cache.mu.Unlock()
+	})
+
+	if flavor, ok := c.cache.getFlavor(flavorID); ok {
+		atomic.AddInt64(&c.cache.hits, 1)
+		return flavor, nil
+	}
+
+	// Flavor not in listing (e.g. deleted or private to another project)
+	atomic.AddInt64(&c.cache.singleCalls, 1)
+	flavor, err := flavors.Get(c.computeClient, flavorID).Extract()
+	if err != nil {
+		return flavors.Flavor{}, err
+	}
+
+	c.cache.mu.Lock()
+	c.cache.flavors[flavorID] = *flavor
+	c.cache.mu.Unlock()
+
+	return *flavor, nil
+}
+
+// lookupServerName returns the server name, listing all servers visible to the client on first use
+func (c *Client) lookupServerName(serverID string) (string, error) {
+	c.loadServers()
+
+	if name, ok := c.cache.getServerName(serverID); ok {
+		atomic.AddInt64(&c.cache.hits, 1)
+		return name, nil
+	}
+
+	atomic.AddInt64(&c.cache.singleCalls, 1)
+	server, err := servers.Get(c.computeClient, serverID).Extract()
+	if err != nil {
+		return "", err
+	}
+
+	c.cache.mu.Lock()
+	c.cache.serverNames[serverID] = server.Name
+	c.cache.mu.Unlock()
+
+	return server.Name, nil
+}
+
+// lookupVolume returns the name of a volume and whether it still exists,
+// listing all volumes visible to the client on first use
+func (c *Client) lookupVolume(volumeID string) (string, bool, error) {
+	c.cache.loadOnce(c.cacheScope("volumes", c.blockstorageClient.Endpoint), func() {
+		listOpts := volumes.ListOpts{AllTenants: c.allTenants()}
+		allPages, err := volumes.List(c.blockstorageClient, listOpts).AllPages()
+		if err != nil && listOpts.AllTenants {
+			// Fallback to current tenant only if AllTenants fails
+			allPages, err = volumes.List(c.blockstorageClient, volumes.ListOpts{}).AllPages()
+		}
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to list volumes for cache: %v\n", err)
+			return
+		}
+		volumeList, err := volumes.ExtractVolumes(allPages)
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to extract volumes for cache: %v\n", err)
+			return
+		}
+		c.cache.mu.Lock()
+		for _, volume := range volumeList {
+			c.cache.volumeNames[volume.ID] = volume.Name
+		}
+		c.cache.mu.Unlock()
+	})
+
+	if name, ok := c.cache.getVolumeName(volumeID); ok {
+		atomic.AddInt64(&c.cache.hits, 1)
+		return name, true, nil
+	}
+
+	atomic.AddInt64(&c.cache.singleCalls, 1)
+	volume, err := volumes.Get(c.blockstorageClient, volumeID).Extract()
+	if err != nil {
+		if _, notFound := err.(gophercloud.ErrDefault404); notFound {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	c.cache.mu.Lock()
+	c.cache.volumeNames[volumeID] = volume.Name
+	c.cache.mu.Unlock()
+
+	return volume.Name, true, nil
+}
+
+// addPortListing records the port listing of a scope and endpoint
+func (lc *lookupCache) addPortListing(key string, portList []ports.Port) {
+	lc.addPorts(portList)
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.portLists[key] = portList
+}
+
+// scopePorts returns the ports listed for the client's scope and endpoint, listing them on first use
+func (c *Client) scopePorts() []ports.Port {
+	c.loadPorts()
+	c.cache.mu.RLock()
+	defer c.cache.mu.RUnlock()
+	return c.cache.portLists[c.cacheScope("ports", c.networkClient.Endpoint)]
+}
+
+// loadPorts lists all ports visible to the client once per run
+func (c *Client) loadPorts() {
+	key := c.cacheScope("ports", c.networkClient.Endpoint)
+	c.cache.loadOnce(key, func() {
+		allPages, err := ports.List(c.networkClient, c.portListOpts()).AllPages()
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to list ports for cache: %v\n", err)
+			return
+		}
+		portList, err := ports.ExtractPorts(allPages)
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to extract ports for cache: %v\n", err)
+			return
+		}
+		c.cache.addPortListing(key, portList)
+	})
+}
+
+// seedPorts marks the port listing of the client's scope as done, using a listing made by a collector
+func (c *Client) seedPorts(portList []ports.Port) {
+	key := c.cacheScope("ports", c.networkClient.Endpoint)
+	c.cache.loadOnce(key, func() {
+		c.cache.addPortListing(key, portList)
+	})
+}
+
+// lookupPort returns the port, listing all ports visible to the client on first use
+func (c *Client) lookupPort(portID string) (ports.Port, error) {
+	c.loadPorts()
+
+	if port, ok := c.cache.getPort(portID); ok {
+		atomic.AddInt64(&c.cache.hits, 1)
+		return port, nil
+	}
+
+	atomic.AddInt64(&c.cache.singleCalls, 1)
+	port, err := ports.Get(c.networkClient, portID).Extract()
+	if err != nil {
+		return ports.Port{}, err
+	}
+
+	c.cache.mu.Lock()
+	c.cache.ports[portID] = *port
+	c.cache.mu.Unlock()
+
+	return *port, nil
+}
@@ -0,0 +1,220 @@
+package openstack
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/hypervisors"
+
+	"openstack-reporter/internal/models"
+)
+
+// collectCapacity collects hypervisors, aggregates and availability zones of every region and
+// rolls the given servers up per host. Regions where the token lacks the admin role are skipped.
+func (c *Client) collectCapacity(resources []models.Resource) []models.RegionCapacity {
+	var capacity []models.RegionCapacity
+	for _, region := range c.collectionRegions() {
+		regionClient, err := c.forRegion(region)
+		if err != nil {
+			fmt.Printf("DEBUG: Skipping capacity of region %s: %v\n", region, err)
+			continue
+		}
+
+		regionCapacity, err := regionClient.getCapacity()
+		if err != nil {
+			fmt.Printf("DEBUG: Capacity not collected%s (admin credentials required): %v\n", regionSuffix(region), err)
+			continue
+		}
+		regionCapacity.Region = region
+		linkHostServers(&regionCapacity, resources)
+		capacity = append(capacity, regionCapacity)
+	}
+	return capacity
+}
+
+// getCapacity lists hypervisors, host aggregates and availability zones of the client's region
+func (c *Client) getCapacity() (models.RegionCapacity, error) {
+	capacity := models.RegionCapacity{
+		Hypervisors:       []models.Hypervisor{},
+		Aggregates:        []models.Aggregate{},
+		AvailabilityZones: []models.AvailabilityZone{},
+	}
+
+	allPages, err := hypervisors.List(c.computeClient, hypervisors.ListOpts{}).AllPages()
+	if err != nil {
+		return capacity, err
+	}
+	hypervisorList, err := hypervisors.ExtractHypervisors(allPages)
+	if err != nil {
+		return capacity, err
+	}
+
+	// Aggregates and zones are optional, a hypervisor listing alone is still useful
+	var aggregateList []aggregates.Aggregate
+	if allPages, err := aggregates.List(c.computeClient).AllPages(); err == nil {
+		aggregateList, _ = aggregates.ExtractAggregates(allPages)
+	} else {
+		fmt.Printf("DEBUG: Failed to list host aggregates: %v\n", err)
+	}
+
+	var zoneList []availabilityzones.AvailabilityZone
+	if allPages, err := availabilityzones.ListDetail(c.computeClient).AllPages(); err == nil {
+		zoneList, _ = availabilityzones.ExtractAvailabilityZones(allPages)
+	} else {
+		fmt.Printf("DEBUG: Failed to list availability zones: %v\n", err)
+	}
+
+	// Index aggregates and zones by compute host
+	hostAggregates := make(map[string][]string)
+	for _, aggregate := range aggregateList {
+		for _, host := range aggregate.Hosts {
+			hostAggregates[host] = append(hostAggregates[host], aggregate.Name)
+		}
+	}
+	hostZones := make(map[string]string)
+	for _, zone := range zoneList {
+		for host, services := range zone.Hosts {
+			if _, compute := services["nova-compute"]; compute {
+				hostZones[host] = zone.ZoneName
+			}
+		}
+	}
+
+	hostUsage := make(map[string]models.CapacityUsage)
+	for _, hypervisor := range hypervisorList {
+		usage := models.CapacityUsage{
+			VCPUs:        hypervisor.VCPUs,
+			VCPUsUsed:    hypervisor.VCPUsUsed,
+			MemoryMB:     hypervisor.MemoryMB,
+			MemoryMBUsed: hypervisor.MemoryMBUsed,
+			LocalGB:      hypervisor.LocalGB,
+			LocalGBUsed:  hypervisor.LocalGBUsed,
+			RunningVMs:   hypervisor.RunningVMs,
+		}
+		host := firstNonEmpty(hypervisor.Service.Host, hypervisor.HypervisorHostname)
+		hostUsage[host] = usage
+		capacity.Totals.Add(usage)
+
+		capacity.Hypervisors = append(capacity.Hypervisors, models.Hypervisor{
+			ID:               hypervisor.ID,
+			Hostname:         hypervisor.HypervisorHostname,
+			Host:             host,
+			Type:             hypervisor.HypervisorType,
+			HostIP:           hypervisor.HostIP,
+			State:            hypervisor.State,
+			Status:           hypervisor.Status,
+			DisabledReason:   hypervisor.Service.DisabledReason,
+			AvailabilityZone: hostZones[host],
+			Aggregates:       hostAggregates[host],
+			Usage:            usage,
+			Projects:         []models.HostProjectUsage{},
+		})
+	}
+	sort.Slice(capacity.Hypervisors, func(i, j int) bool {
+		return capacity.Hypervisors[i].Host < capacity.Hypervisors[j].Host
+	})
+
+	for _, aggregate := range aggregateList {
+		item := models.Aggregate{
+			ID:               aggregate.ID,
+			Name:             aggregate.Name,
+			AvailabilityZone: aggregate.AvailabilityZone,
+			Hosts:            aggregate.Hosts,
+			Metadata:         aggregate.Metadata,
+		}
+		for _, host := range aggregate.Hosts {
+			item.Usage.Add(hostUsage[host])
+		}
+		capacity.Aggregates = append(capacity.Aggregates, item)
+	}
+
+	for _, zone := range zoneList {
+		item := models.AvailabilityZone{
+			Name:      zone.ZoneName,
+			Available: zone.ZoneState.Available,
+			Hosts:     []string{},
+		}
+		for host := range zone.Hosts {
+			if hostZones[host] != zone.ZoneName {
+				continue // internal services such as the scheduler
+			}
+			item.Hosts = append(item.Hosts, host)
+			item.Usage.Add(hostUsage[host])
+		}
+		if len(item.Hosts) == 0 {
+			continue
+		}
+		sort.Strings(item.Hosts)
+		capacity.AvailabilityZones = append(capacity.AvailabilityZones, item)
+	}
+	sort.Slice(capacity.AvailabilityZones, func(i, j int) bool {
+		return capacity.AvailabilityZones[i].Name < capacity.AvailabilityZones[j].Name
+	})
+
+	return capacity, nil
+}
+
+// linkHostServers rolls the servers of the capacity's region up per host and project
+func linkHostServers(capacity *models.RegionCapacity, resources []models.Resource) {
+	hostIndex := make(map[string]int)
+	for i, hypervisor := range capacity.Hypervisors {
+		hostIndex[hypervisor.Host] = i
+	}
+
+	projectIndex := make(map[string]map[string]int) // host -> project ID -> index in Projects
+	for _, resource := range resources {
+		if resource.Type != "server" || resource.Region != capacity.Region {
+			continue
+		}
+		var server models.Server
+		if !models.DecodeProperties(resource.Properties, &server) || server.Host == "" {
+			continue
+		}
+		i, exists := hostIndex[server.Host]
+		if !exists {
+			continue
+		}
+
+		hypervisor := &capacity.Hypervisors[i]
+		if projectIndex[server.Host] == nil {
+			projectIndex[server.Host] = make(map[string]int)
+		}
+		j, exists := projectIndex[server.Host][resource.ProjectID]
+		if !exists {
+			j = len(hypervisor.Projects)
+			projectIndex[server.Host][resource.ProjectID] = j
+			hypervisor.Projects = append(hypervisor.Projects, models.HostProjectUsage{
+				ProjectID:   resource.ProjectID,
+				ProjectName: resource.ProjectName,
+			})
+		}
+		hypervisor.Projects[j].Servers++
+		hypervisor.Projects[j].VCPUs += server.VCPUs
+		hypervisor.Projects[j].RAMMB += server.RAMMB
+	}
+
+	// Largest consumers first
+	for i := range capacity.Hypervisors {
+		projects := capacity.Hypervisors[i].Projects
+		sort.Slice(projects, func(a, b int) bool {
+			if projects[a].VCPUs != projects[b].VCPUs {
+				return projects[a].VCPUs > projects[b].VCPUs
+			}
+			return projects[a].ProjectName < projects[b].ProjectName
+		})
+	}
+}
+
+// getFlavorSize returns the vCPUs and RAM of a flavor, zero when it is unknown
+func (c *Client) getFlavorSize(flavorID string) (int, int) {
+	if flavorID == "" {
+		return 0, 0
+	}
+	flavor, err := c.lookupFlavor(flavorID)
+	if err != nil {
+		return 0, 0
+	}
+	return flavor.VCPUs, flavor.RAM
+}
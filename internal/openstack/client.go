@@ -1,20 +1,22 @@
 package openstack
 
 import (
-	"crypto/tls"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"os"
 	"os/exec"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
-	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clusters"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clustertemplates"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/nodegroups"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
@@ -23,7 +25,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/siteconnections"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
-	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/pagination"
 
 	"openstack-reporter/internal/models"
 )
@@ -35,19 +37,29 @@ type ProgressReporter interface {
 
 // ProgressMessage represents a progress update message
 type ProgressMessage struct {
-	Type        string         `json:"type"`
-	Message     string         `json:"message"`
-	CurrentStep int            `json:"current_step,omitempty"`
-	TotalSteps  int            `json:"total_steps,omitempty"`
-	Project     string         `json:"project,omitempty"`
-	ResourceType string        `json:"resource_type,omitempty"`
-	Count       int            `json:"count,omitempty"`
-	Summary     map[string]int `json:"summary,omitempty"`
+	Type         string         `json:"type"`
+	Message      string         `json:"message"`
+	CurrentStep  int            `json:"current_step,omitempty"`
+	TotalSteps   int            `json:"total_steps,omitempty"`
+	Project      string         `json:"project,omitempty"`
+	ResourceType string         `json:"resource_type,omitempty"`
+	Count        int            `json:"count,omitempty"`
+	Summary      map[string]int `json:"summary,omitempty"`
+	Region       string         `json:"region,omitempty"`
+	Cloud        string         `json:"cloud,omitempty"`
+	Retries      int            `json:"retries,omitempty"` // requests to the cloud retried since the refresh started
 }
 
 // ChannelProgressReporter implements ProgressReporter using channels
 type ChannelProgressReporter struct {
 	progressChan chan ProgressMessage
+	cloud        string
+	region       string
+	// transport counts the retried requests of the cloud, retryBase is its count when the refresh started
+	transport *cloudTransport
+	retryBase int64
+	// ctx ends the refresh, sends wait for room in the channel until then
+	ctx context.Context
 }
 
 func NewChannelProgressReporter(progressChan chan ProgressMessage) *ChannelProgressReporter {
@@ -70,130 +82,236 @@ func (r *ChannelProgressReporter) SendProgress(msgType, message string, currentS
 		ResourceType: resourceType,
 		Count:        count,
 		Summary:      summary,
+		Region:       r.region,
+		Cloud:        r.cloud,
+	}
+	if r.transport != nil {
+		progressMsg.Retries = int(r.transport.Retries() - r.retryBase)
 	}
 
+	// Wait for the reader instead of dropping events, unless the refresh is over
+	var done <-chan struct{}
+	if r.ctx != nil {
+		done = r.ctx.Done()
+	}
 	select {
 	case r.progressChan <- progressMsg:
-	default:
-		// Channel full, skip this update
+	case <-done:
+	}
+}
+
+// ForRegion returns a reporter on the same channel that tags messages with region
+func (r *ChannelProgressReporter) ForRegion(region string) ProgressReporter {
+	return &ChannelProgressReporter{
+		progressChan: r.progressChan,
+		cloud:        r.cloud,
+		region:       region,
+		transport:    r.transport,
+		retryBase:    r.retryBase,
+		ctx:          r.ctx,
 	}
 }
 
+// reporterForRegion tags progress with region when the reporter supports it
+func reporterForRegion(reporter ProgressReporter, region string) ProgressReporter {
+	if regional, ok := reporter.(interface{ ForRegion(string) ProgressReporter }); ok {
+		return regional.ForRegion(region)
+	}
+	return reporter
+}
+
 type Client struct {
-	provider         *gophercloud.ProviderClient
-	computeClient    *gophercloud.ServiceClient
-	blockstorageClient *gophercloud.ServiceClient
-	networkClient    *gophercloud.ServiceClient
-	identityClient   *gophercloud.ServiceClient
-	loadbalancerClient *gophercloud.ServiceClient
-	containerClient  *gophercloud.ServiceClient
+	provider            *gophercloud.ProviderClient
+	computeClient       *gophercloud.ServiceClient
+	blockstorageClient  *gophercloud.ServiceClient
+	networkClient       *gophercloud.ServiceClient
+	identityClient      *gophercloud.ServiceClient
+	loadbalancerClient  *gophercloud.ServiceClient
+	containerClient     *gophercloud.ServiceClient
+	imageClient         *gophercloud.ServiceClient
+	objectClient        *gophercloud.ServiceClient
+	dnsClient           *gophercloud.ServiceClient
+	orchestrationClient *gophercloud.ServiceClient
+
+	// config is the cloud configuration the client was created from
+	config *cloudConfig
+	// scope is the project ID of a project-scoped client, empty for the main client
+	scope string
+	// cache holds lookups shared by all clients of the current collection run
+	cache *lookupCache
+	// runProjects maps the IDs of all projects of a per-project collection run to their names,
+	// nil when the run isn't collected per project
+	runProjects map[string]string
+	// ctx bounds every request of the client, see bindContext
+	ctx context.Context
 }
 
-// NewClient creates a new OpenStack client
-func NewClient() (*Client, error) {
-	projectName := os.Getenv("OS_PROJECT_NAME")
+// allTenants reports whether listings of this client should request all tenants
+func (c *Client) allTenants() bool {
+	return c.scope == "" && c.config.ProjectName == ""
+}
 
-	// If no project specified, use a default project for initialization
-	// The actual multi-project logic will happen in GetAllResources()
-	if projectName == "" {
-		projectName = "infra" // Use a known project for initialization
-		fmt.Printf("DEBUG: No OS_PROJECT_NAME specified, using '%s' for client initialization\n", projectName)
+// NewClient creates a new OpenStack client whose requests are bound to ctx
+func NewClient(ctx context.Context) (*Client, error) {
+	config, err := loadCloudConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenStack configuration: %w", err)
 	}
 
-	opts := gophercloud.AuthOptions{
-		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
-		Username:         os.Getenv("OS_USERNAME"),
-		Password:         os.Getenv("OS_PASSWORD"),
-		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
-		TenantName:       projectName,
-	}
+	return newClient(ctx, config)
+}
 
-	// Handle insecure connections
-	var provider *gophercloud.ProviderClient
-	var err error
+// newClient authenticates against the cloud described by config
+func newClient(ctx context.Context, config *cloudConfig) (*Client, error) {
+	projectName := config.ProjectName
 
-	if os.Getenv("OS_INSECURE") == "true" {
-		config := &tls.Config{InsecureSkipVerify: true}
-		transport := &http.Transport{TLSClientConfig: config}
-		client := &http.Client{Transport: transport}
-		provider, err = openstack.NewClient(opts.IdentityEndpoint)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create provider client: %w", err)
-		}
-		provider.HTTPClient = *client
-		err = openstack.Authenticate(provider, opts)
-	} else {
-		provider, err = openstack.AuthenticatedClient(opts)
+	// If no project specified, use a default project for initialization
+	// The actual multi-project logic will happen in GetAllResources()
+	if projectName == "" {
+		projectName = "infra" // Use a known project for initialization
+		fmt.Printf("DEBUG: No project name specified, using '%s' for client initialization\n", projectName)
 	}
 
+	provider, err := config.projectProvider(ctx, config.ProjectID, projectName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create authenticated client: %w", err)
 	}
 
-	computeClient, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
+	return newClientFromProvider(ctx, provider, config, "")
+}
+
+// newClientFromProvider creates service clients for an authenticated provider, bound to ctx.
+// scope is the project ID of a project-scoped client, empty for the main client.
+func newClientFromProvider(ctx context.Context, provider *gophercloud.ProviderClient, config *cloudConfig, scope string) (*Client, error) {
+	endpointOpts := config.endpointOpts()
+
+	computeClient, err := openstack.NewComputeV2(provider, endpointOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create compute client: %w", err)
 	}
 
-	blockstorageClient, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
+	blockstorageClient, err := openstack.NewBlockStorageV3(provider, endpointOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create block storage client: %w", err)
 	}
 
-	networkClient, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
+	networkClient, err := openstack.NewNetworkV2(provider, endpointOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create network client: %w", err)
 	}
 
-	identityClient, err := openstack.NewIdentityV3(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
+	identityClient, err := openstack.NewIdentityV3(provider, endpointOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create identity client: %w", err)
 	}
 
-	loadbalancerClient, err := openstack.NewLoadBalancerV2(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
+	loadbalancerClient, err := openstack.NewLoadBalancerV2(provider, endpointOpts)
 	if err != nil {
 		// Load balancer service might not be available
 		loadbalancerClient = nil
 	}
 
-	containerClient, err := openstack.NewContainerInfraV1(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
+	containerClient, err := openstack.NewContainerInfraV1(provider, endpointOpts)
 	if err != nil {
 		// Container service might not be available
 		containerClient = nil
 	}
 
-	return &Client{
-		provider:           provider,
-		computeClient:      computeClient,
-		blockstorageClient: blockstorageClient,
-		networkClient:      networkClient,
-		identityClient:     identityClient,
-		loadbalancerClient: loadbalancerClient,
-		containerClient:    containerClient,
-	}, nil
+	imageClient, err := openstack.NewImageServiceV2(provider, endpointOpts)
+	if err != nil {
+		// Image service might not be available
+		imageClient = nil
+	}
+
+	objectClient, err := openstack.NewObjectStorageV1(provider, endpointOpts)
+	if err != nil {
+		// Object storage service might not be available
+		objectClient = nil
+	}
+
+	dnsClient, err := openstack.NewDNSV2(provider, endpointOpts)
+	if err != nil {
+		// DNS service might not be available
+		dnsClient = nil
+	}
+
+	orchestrationClient, err := openstack.NewOrchestrationV1(provider, endpointOpts)
+	if err != nil {
+		// Orchestration service might not be available
+		orchestrationClient = nil
+	}
+
+	client := &Client{
+		provider:            provider,
+		computeClient:       computeClient,
+		blockstorageClient:  blockstorageClient,
+		networkClient:       networkClient,
+		identityClient:      identityClient,
+		loadbalancerClient:  loadbalancerClient,
+		containerClient:     containerClient,
+		imageClient:         imageClient,
+		objectClient:        objectClient,
+		dnsClient:           dnsClient,
+		orchestrationClient: orchestrationClient,
+		config:              config,
+		scope:               scope,
+		cache:               newLookupCache(),
+	}
+	client.bindContext(ctx)
+
+	return client, nil
+}
+
+// bindContext makes every request of the client use ctx. All service clients share the
+// provider and with it the token, so the request timeout of a service is looked up by the
+// transport from the endpoint each service client registers.
+func (c *Client) bindContext(ctx context.Context) {
+	c.ctx = ctx
+	c.provider.Context = ctx
+	for _, service := range []string{"compute", "block-storage", "network", "identity", "load-balancer", "container-infra", "image", "object-store", "dns", "orchestration"} {
+		if serviceClient := c.serviceClient(service); serviceClient != nil {
+			c.config.transport().registerEndpoint(serviceClient.Endpoint, service)
+		}
+	}
 }
 
-// GetAllResources fetches all resources from OpenStack
-func (c *Client) GetAllResources() (*models.ResourceReport, error) {
+// GetAllResources fetches all resources from OpenStack. The collection stops when ctx is done
+// and returns ErrRefreshTimedOut when its deadline passed.
+func (c *Client) GetAllResources(ctx context.Context) (*models.ResourceReport, error) {
+	c.bindContext(ctx)
+	retryBase := c.config.transport().Retries()
+	report, err := c.collectAllResources()
+	if ctxErr := ContextError(ctx); ctxErr != nil {
+		return nil, ctxErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("DEBUG: %d API requests retried\n", c.config.transport().Retries()-retryBase)
+	linkImageUsage(report.Resources)
+	linkDNSNames(report.Resources)
+	linkStackResources(report.Resources)
+	report.Capacity = c.collectCapacity(report.Resources)
+	report.Access = c.collectAccess(report.Projects)
+	if err := ContextError(ctx); err != nil {
+		return nil, err
+	}
+	return tagCloud(report, c.config.Name), nil
+}
+
+// collectAllResources fetches all resources of the client's cloud
+func (c *Client) collectAllResources() (*models.ResourceReport, error) {
 	report := &models.ResourceReport{
 		GeneratedAt: time.Now(),
 		Resources:   []models.Resource{},
 	}
 
+	// Lookups are shared by all clients of this run and dropped afterwards
+	c.cache = newLookupCache()
+	c.runProjects = nil
+
 	// Check if user wants all projects or specific project
-	projectName := strings.TrimSpace(os.Getenv("OS_PROJECT_NAME"))
+	projectName := c.config.ProjectName
 	if projectName != "" {
 		// Single project mode - use current client
 		fmt.Printf("DEBUG: Single project mode: %s\n", projectName)
@@ -218,7 +336,7 @@ func (c *Client) GetAllResources() (*models.ResourceReport, error) {
 		fmt.Printf("DEBUG: API project list failed: %v\n", err)
 		// Fallback to CLI method
 		fmt.Printf("DEBUG: Trying CLI fallback...\n")
-		allProjects, err = getProjectsViaCommand()
+		allProjects, err = getProjectsViaCommand(c.ctx)
 		if err != nil {
 			fmt.Printf("DEBUG: CLI project list also failed: %v\n", err)
 			// Final fallback to current project
@@ -234,29 +352,52 @@ func (c *Client) GetAllResources() (*models.ResourceReport, error) {
 	}
 
 	report.Projects = allProjects
+
+	// Application credentials can't be rescoped to other projects, so everything
+	// is collected through the credential's own token with all-tenants listings
+	if c.config.usesApplicationCredential() {
+		fmt.Printf("DEBUG: Application credential in use, collecting %d projects with all-tenants listings\n", len(allProjects))
+		return c.collectResourcesForProjects(report, projectNameMap(allProjects))
+	}
+
 	fmt.Printf("DEBUG: Found %d projects, collecting resources from each\n", len(allProjects))
 
-	// Collect resources from each project separately
-	var allResources []models.Resource
+	// Collect resources from each project separately, several projects at a time
+	c.runProjects = projectNameMap(allProjects)
 	totalProjects := len(allProjects)
+	projectResults := make([][]models.Resource, totalProjects)
+	projectQuotas := make([][]models.ProjectQuota, totalProjects)
 
-	for i, project := range allProjects {
+	runBounded(totalProjects, getConcurrencyLimit("PROJECT_CONCURRENCY", defaultProjectConcurrency), func(i int) {
+		project := allProjects[i]
+		if c.ctx.Err() != nil {
+			return // The refresh was canceled or timed out, skip the remaining projects
+		}
 		fmt.Printf("🔍 [%d/%d] Collecting resources from project: %s (%s)\n", i+1, totalProjects, project.Name, project.ID)
 
-		projectResources, err := getResourcesForProject(project)
+		projectResources, quotas, err := c.getResourcesForProject(project)
 		if err != nil {
 			fmt.Printf("❌ Failed to get resources for project %s: %v\n", project.Name, err)
-			continue // Skip this project, continue with others
+			return // Skip this project, continue with others
 		}
 
 		fmt.Printf("✅ Found %d resources in project %s\n", len(projectResources), project.Name)
+		projectResults[i] = projectResources
+		projectQuotas[i] = quotas
+	})
+
+	// Merge in project order so the report does not depend on scheduling
+	var allResources []models.Resource
+	for i, projectResources := range projectResults {
 		allResources = append(allResources, projectResources...)
+		report.Quotas = append(report.Quotas, projectQuotas[i]...)
 	}
 
 	report.Resources = allResources
 	report.Summary = c.calculateSummary(report.Resources, len(report.Projects))
 
 	fmt.Printf("\n🎯 SUMMARY: Total %d resources collected from %d projects\n", len(allResources), len(allProjects))
+	fmt.Printf("📦 Lookup cache: %s\n", c.cache.Stats())
 
 	// Show breakdown by resource type
 	typeCount := make(map[string]int)
@@ -272,17 +413,58 @@ func (c *Client) GetAllResources() (*models.ResourceReport, error) {
 	return report, nil
 }
 
-// GetAllResourcesWithProgress fetches all resources from OpenStack with progress updates
-func (c *Client) GetAllResourcesWithProgress(progressChan chan ProgressMessage) (*models.ResourceReport, error) {
+// GetAllResourcesWithProgress fetches all resources from OpenStack with progress updates. The
+// collection stops when ctx is done and returns ErrRefreshTimedOut when its deadline passed.
+func (c *Client) GetAllResourcesWithProgress(ctx context.Context, progressChan chan ProgressMessage) (*models.ResourceReport, error) {
+	c.bindContext(ctx)
 	reporter := NewChannelProgressReporter(progressChan)
+	reporter.cloud = c.config.Name
+	reporter.transport = c.config.transport()
+	reporter.retryBase = reporter.transport.Retries()
+	reporter.ctx = ctx
 
+	report, err := c.collectAllResourcesWithProgress(reporter)
+	if ctxErr := ContextError(ctx); ctxErr != nil {
+		return nil, ctxErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	linkImageUsage(report.Resources)
+	linkDNSNames(report.Resources)
+	linkStackResources(report.Resources)
+
+	reporter.SendProgress("resource_start", "Collecting hypervisor capacity", 0, 0, "", "capacity", 0, nil)
+	report.Capacity = c.collectCapacity(report.Resources)
+	hypervisorCount := 0
+	for _, regionCapacity := range report.Capacity {
+		hypervisorCount += len(regionCapacity.Hypervisors)
+	}
+	reporter.SendProgress("resource_complete", "Hypervisor capacity collected", 0, 0, "", "capacity", hypervisorCount, nil)
+
+	reporter.SendProgress("resource_start", "Collecting project role assignments", 0, 0, "", "access", 0, nil)
+	report.Access = c.collectAccess(report.Projects)
+	reporter.SendProgress("resource_complete", "Project role assignments collected", 0, 0, "", "access", len(report.Access), nil)
+
+	if err := ContextError(ctx); err != nil {
+		return nil, err
+	}
+	return tagCloud(report, c.config.Name), nil
+}
+
+// collectAllResourcesWithProgress fetches all resources of the client's cloud with progress updates
+func (c *Client) collectAllResourcesWithProgress(reporter ProgressReporter) (*models.ResourceReport, error) {
 	report := &models.ResourceReport{
 		GeneratedAt: time.Now(),
 		Resources:   []models.Resource{},
 	}
 
+	// Lookups are shared by all clients of this run and dropped afterwards
+	c.cache = newLookupCache()
+	c.runProjects = nil
+
 	// Check if user wants all projects or specific project
-	projectName := strings.TrimSpace(os.Getenv("OS_PROJECT_NAME"))
+	projectName := c.config.ProjectName
 	if projectName != "" {
 		// Single project mode - use current client
 		reporter.SendProgress("progress", "Single project mode: "+projectName, 0, 0, "", "", 0, nil)
@@ -308,7 +490,7 @@ func (c *Client) GetAllResourcesWithProgress(progressChan chan ProgressMessage)
 		fmt.Printf("DEBUG: API project list failed: %v\n", err)
 		reporter.SendProgress("progress", "API project list failed, trying CLI fallback", 0, 0, "", "", 0, nil)
 		fmt.Printf("DEBUG: Attempting to get projects via CLI...\n")
-		allProjects, err = getProjectsViaCommand()
+		allProjects, err = getProjectsViaCommand(c.ctx)
 		if err != nil {
 			fmt.Printf("DEBUG: CLI project list failed: %v\n", err)
 			fmt.Printf("DEBUG: Using single project fallback mode\n")
@@ -326,24 +508,50 @@ func (c *Client) GetAllResourcesWithProgress(progressChan chan ProgressMessage)
 	}
 
 	report.Projects = allProjects
+
+	// Application credentials can't be rescoped to other projects, so everything
+	// is collected through the credential's own token with all-tenants listings
+	if c.config.usesApplicationCredential() {
+		reporter.SendProgress("progress", fmt.Sprintf("Found %d projects, collecting with application credential", len(allProjects)), 0, 0, "", "", 0, nil)
+		return c.collectResourcesForProjectsWithProgress(report, projectNameMap(allProjects), reporter)
+	}
+
 	fmt.Printf("DEBUG: Successfully found %d projects via API/CLI, entering true multi-project mode\n", len(allProjects))
 	reporter.SendProgress("progress", fmt.Sprintf("Found %d projects, starting resource collection", len(allProjects)), 0, len(allProjects), "", "", 0, nil)
 
-	// Collect resources from each project separately
-	var allResources []models.Resource
+	// Collect resources from each project separately, several projects at a time.
+	// Steps are counted as projects start and finish so progress stays monotonic.
+	c.runProjects = projectNameMap(allProjects)
 	totalProjects := len(allProjects)
+	projectResults := make([][]models.Resource, totalProjects)
+	projectQuotas := make([][]models.ProjectQuota, totalProjects)
+	var startedProjects, finishedProjects int32
+
+	runBounded(totalProjects, getConcurrencyLimit("PROJECT_CONCURRENCY", defaultProjectConcurrency), func(i int) {
+		project := allProjects[i]
+		if c.ctx.Err() != nil {
+			return // The refresh was canceled or timed out, skip the remaining projects
+		}
+		step := int(atomic.AddInt32(&startedProjects, 1))
+		reporter.SendProgress("project_start", fmt.Sprintf("Collecting resources from project: %s", project.Name), step, totalProjects, project.Name, "", 0, nil)
 
-	for i, project := range allProjects {
-		reporter.SendProgress("project_start", fmt.Sprintf("Collecting resources from project: %s", project.Name), i+1, totalProjects, project.Name, "", 0, nil)
-
-		projectResources, err := getResourcesForProjectWithProgress(project, reporter)
+		projectResources, quotas, err := c.getResourcesForProjectWithProgress(project, reporter)
+		step = int(atomic.AddInt32(&finishedProjects, 1))
 		if err != nil {
-			reporter.SendProgress("project_error", fmt.Sprintf("Failed to get resources for project %s: %v", project.Name, err), i+1, totalProjects, project.Name, "", 0, nil)
-			continue // Skip this project, continue with others
+			reporter.SendProgress("project_error", fmt.Sprintf("Failed to get resources for project %s: %v", project.Name, err), step, totalProjects, project.Name, "", 0, nil)
+			return // Skip this project, continue with others
 		}
 
-		reporter.SendProgress("project_complete", fmt.Sprintf("Found %d resources in project %s", len(projectResources), project.Name), i+1, totalProjects, project.Name, "", len(projectResources), nil)
+		reporter.SendProgress("project_complete", fmt.Sprintf("Found %d resources in project %s", len(projectResources), project.Name), step, totalProjects, project.Name, "", len(projectResources), nil)
+		projectResults[i] = projectResources
+		projectQuotas[i] = quotas
+	})
+
+	// Merge in project order so the report does not depend on scheduling
+	var allResources []models.Resource
+	for i, projectResources := range projectResults {
 		allResources = append(allResources, projectResources...)
+		report.Quotas = append(report.Quotas, projectQuotas[i]...)
 	}
 
 	report.Resources = allResources
@@ -355,11 +563,21 @@ func (c *Client) GetAllResourcesWithProgress(progressChan chan ProgressMessage)
 		typeCount[resource.Type]++
 	}
 
+	fmt.Printf("DEBUG: Lookup cache: %s\n", c.cache.Stats())
 	reporter.SendProgress("summary", fmt.Sprintf("Total %d resources collected from %d projects", len(allResources), len(allProjects)), totalProjects, totalProjects, "", "", len(allResources), typeCount)
 
 	return report, nil
 }
 
+// projectNameMap maps project IDs to names
+func projectNameMap(projectList []models.Project) map[string]string {
+	projectNames := make(map[string]string)
+	for _, project := range projectList {
+		projectNames[project.ID] = project.Name
+	}
+	return projectNames
+}
+
 func (c *Client) getAllProjects() ([]models.Project, error) {
 	// Try to list all projects the user has access to
 	fmt.Printf("DEBUG: Attempting to list all accessible projects...\n")
@@ -402,6 +620,15 @@ func (c *Client) getAllProjects() ([]models.Project, error) {
 	return result, nil
 }
 
+// tokenProjectID returns the project the client's token is scoped to, empty when it isn't project-scoped
+func (c *Client) tokenProjectID() string {
+	project, err := tokenProject(c.provider)
+	if err != nil {
+		return ""
+	}
+	return project.ID
+}
+
 func (c *Client) getCurrentProject() (models.Project, error) {
 	// Get current token to extract project info
 	authResult := c.provider.GetAuthResult()
@@ -410,14 +637,26 @@ func (c *Client) getCurrentProject() (models.Project, error) {
 	}
 
 	// Use simple approach - get project from environment or use fallback
-	projectID := os.Getenv("OS_PROJECT_ID")
-	projectName := os.Getenv("OS_PROJECT_NAME")
+	projectID := c.config.ProjectID
+	projectName := c.config.ProjectName
+
+	// Application credential tokens are always scoped to the credential's project
+	if c.config.usesApplicationCredential() && projectID == "" {
+		if project, err := tokenProject(c.provider); err == nil {
+			return models.Project{
+				ID:       project.ID,
+				Name:     project.Name,
+				DomainID: project.Domain.ID,
+				Enabled:  true,
+			}, nil
+		}
+	}
 
 	if projectName == "" {
 		projectName = "Current Project"
 	}
 
-		if projectID == "" {
+	if projectID == "" {
 		projectID = "current-project"
 	}
 
@@ -450,7 +689,7 @@ func (c *Client) getServers(projectNames map[string]string) ([]models.Resource,
 
 	// Check if user wants all projects or specific project
 	var listOpts servers.ListOpts
-	projectName := strings.TrimSpace(os.Getenv("OS_PROJECT_NAME"))
+	projectName := c.config.ProjectName
 	if projectName == "" {
 		// No specific project requested - get all accessible projects
 		fmt.Printf("DEBUG: No specific project set, using AllTenants=true\n")
@@ -461,12 +700,12 @@ func (c *Client) getServers(projectNames map[string]string) ([]models.Resource,
 		listOpts = servers.ListOpts{}
 	}
 
-	allPages, err := servers.List(c.computeClient, listOpts).AllPages()
+	allPages, err := c.listServers(listOpts)
 	if err != nil && listOpts.AllTenants {
 		// Fallback to current tenant only if AllTenants fails
 		fmt.Printf("DEBUG: AllTenants failed, falling back to current project only\n")
 		listOpts = servers.ListOpts{}
-		allPages, err = servers.List(c.computeClient, listOpts).AllPages()
+		allPages, err = c.listServers(listOpts)
 	}
 	if err != nil {
 		return nil, err
@@ -476,6 +715,9 @@ func (c *Client) getServers(projectNames map[string]string) ([]models.Resource,
 	if err != nil {
 		return nil, err
 	}
+	// After a failed all-tenants listing only the current project's servers are known
+	c.seedServers(serverList, listOpts.AllTenants == c.allTenants())
+	attributes := extractServerAttributes(allPages)
 
 	var resources []models.Resource
 	for _, server := range serverList {
@@ -490,9 +732,6 @@ func (c *Client) getServers(projectNames map[string]string) ([]models.Resource,
 			projectID = currentProject.ID
 		}
 
-				// Get detailed flavor information
-		flavorName, flavorID := c.getFlavorDetails(server.Flavor)
-
 		resources = append(resources, models.Resource{
 			ID:          server.ID,
 			Name:        server.Name,
@@ -502,16 +741,7 @@ func (c *Client) getServers(projectNames map[string]string) ([]models.Resource,
 			Status:      server.Status,
 			CreatedAt:   created,
 			UpdatedAt:   updated,
-			Properties: models.Server{
-				ID:         server.ID,
-				Name:       server.Name,
-				Status:     server.Status,
-				FlavorName: flavorName,
-				FlavorID:   flavorID,
-				Networks:   extractNetworks(server.Addresses),
-				CreatedAt:  created,
-				UpdatedAt:  updated,
-			},
+			Properties:  c.serverProperties(server, attributes[server.ID]),
 		})
 	}
 
@@ -523,7 +753,7 @@ func (c *Client) getVolumes(projectNames map[string]string) ([]models.Resource,
 
 	// Check if user wants all projects or specific project
 	var listOpts volumes.ListOpts
-	projectName := strings.TrimSpace(os.Getenv("OS_PROJECT_NAME"))
+	projectName := c.config.ProjectName
 	if projectName == "" {
 		// No specific project requested - get all accessible projects
 		fmt.Printf("DEBUG: No specific project set, using AllTenants=true for volumes\n")
@@ -549,14 +779,23 @@ func (c *Client) getVolumes(projectNames map[string]string) ([]models.Resource,
 	if err != nil {
 		return nil, err
 	}
+	owners := volumeProjects(allPages)
 
 	var resources []models.Resource
 	for _, volume := range volumeList {
 		created := volume.CreatedAt
 
-		// Use current project as fallback when we can't determine the actual project
-		projectID := currentProject.ID
-		projectName := currentProject.Name
+		// A project-scoped listing only returns volumes of the current project. Volumes of
+		// an all-tenants listing whose owner isn't known are not moved to the current project.
+		projectID := owners[volume.ID]
+		projectName := projectNames[projectID]
+		switch {
+		case projectID == "" && !listOpts.AllTenants:
+			projectID = currentProject.ID
+			projectName = currentProject.Name
+		case projectName == "":
+			projectName = unknownProjectName
+		}
 
 		// Get detailed attachment information including server names
 		attachments := c.getVolumeAttachments(volume.Attachments)
@@ -590,6 +829,42 @@ func (c *Client) getVolumes(projectNames map[string]string) ([]models.Resource,
 	return resources, nil
 }
 
+// unknownProjectName is the project name of resources whose owner isn't among the collected projects
+const unknownProjectName = "unknown project"
+
+// ownerProject returns the project ID and name a listed resource is reported under. A resource
+// without owner comes from a project-scoped listing of the current project, unless the listing
+// covered all projects. Owners outside the collected projects keep their ID with unknownProjectName.
+func ownerProject(projectID string, projectNames map[string]string, allTenants bool, currentProject models.Project) (string, string) {
+	projectName := projectNames[projectID]
+	switch {
+	case projectID == "" && !allTenants:
+		return currentProject.ID, currentProject.Name
+	case projectName == "":
+		return projectID, unknownProjectName
+	}
+	return projectID, projectName
+}
+
+// volumeProjects maps volume IDs to their projects. The project is only returned
+// to admins, so the map is empty for other users.
+func volumeProjects(allPages pagination.Page) map[string]string {
+	var attributes []struct {
+		ID       string `json:"id"`
+		TenantID string `json:"os-vol-tenant-attr:tenant_id"`
+	}
+	projects := make(map[string]string)
+	if err := volumes.ExtractVolumesInto(allPages, &attributes); err != nil {
+		return projects
+	}
+	for _, volume := range attributes {
+		if volume.TenantID != "" {
+			projects[volume.ID] = volume.TenantID
+		}
+	}
+	return projects
+}
+
 func (c *Client) getVolumesForProject(projectID, projectName string) ([]models.Resource, error) {
 	// Use TenantID filter to get volumes for specific project
 	listOpts := volumes.ListOpts{
@@ -762,16 +1037,26 @@ func (c *Client) getNetworks(projectNames map[string]string) ([]models.Resource,
 	if err != nil {
 		return nil, err
 	}
+	attributes := extractNetworkAttributes(allPages)
 
 	var resources []models.Resource
 	for _, network := range networkList {
 		created := network.CreatedAt
 		updated := network.UpdatedAt
+		networkAttributes := attributes[network.ID]
 
-		// Get project name, fallback to current project if not found
-		projectName := projectNames[network.TenantID]
+		// Networks of other projects, e.g. external and shared ones, are visible to many
+		// projects. They are left to the pass of the owning project when the run collects
+		// it, otherwise reported once with their owner.
 		projectID := network.TenantID
-		if projectName == "" {
+		projectName := projectNames[projectID]
+		if projectID != "" && projectName == "" {
+			if c.runProjects[projectID] != "" || !c.cache.claim("network|"+c.networkClient.Endpoint+"|"+network.ID) {
+				continue
+			}
+			projectName = unknownProjectName
+		}
+		if projectID == "" {
 			projectName = currentProject.Name
 			projectID = currentProject.ID
 		}
@@ -789,16 +1074,21 @@ func (c *Client) getNetworks(projectNames map[string]string) ([]models.Resource,
 			CreatedAt:   created,
 			UpdatedAt:   updated,
 			Properties: models.Network{
-				ID:           network.ID,
-				Name:         network.Name,
-				Status:       network.Status,
-				AdminStateUp: network.AdminStateUp,
-				Shared:       network.Shared,
-				External:     false, // Default value, not available in basic Network struct
-				NetworkType:  "local", // Default value, not available in basic Network struct
-				Subnets:      subnets,
-				CreatedAt:    created,
-				UpdatedAt:    updated,
+				ID:                  network.ID,
+				Name:                network.Name,
+				Status:              network.Status,
+				AdminStateUp:        network.AdminStateUp,
+				Shared:              network.Shared,
+				External:            networkAttributes.External,
+				NetworkType:         networkAttributes.NetworkType,
+				PhysicalNetwork:     networkAttributes.PhysicalNetwork,
+				SegmentationID:      segmentationID(networkAttributes.SegmentationID),
+				MTU:                 networkAttributes.MTU,
+				PortSecurityEnabled: networkAttributes.PortSecurityEnabled,
+				AvailabilityZones:   networkAttributes.AvailabilityZones,
+				Subnets:             subnets,
+				CreatedAt:           created,
+				UpdatedAt:           updated,
 			},
 		})
 	}
@@ -823,6 +1113,7 @@ func (c *Client) getLoadBalancers(projectNames map[string]string) ([]models.Reso
 	if err != nil {
 		return nil, err
 	}
+	topology := c.getLoadBalancerTopology()
 
 	var resources []models.Resource
 	for _, lb := range lbList {
@@ -854,6 +1145,8 @@ func (c *Client) getLoadBalancers(projectNames map[string]string) ([]models.Reso
 				OperatingStatus:    lb.OperatingStatus,
 				VipAddress:         lb.VipAddress,
 				VipSubnetID:        lb.VipSubnetID,
+				Listeners:          topology.listeners[lb.ID],
+				Pools:              topology.pools[lb.ID],
 				CreatedAt:          created,
 				UpdatedAt:          updated,
 			},
@@ -950,7 +1243,7 @@ func (c *Client) getVPNConnections(projectNames map[string]string) ([]models.Res
 	// Get current project info for fallback
 	currentProject, _ := c.getCurrentProject()
 
-		// Get VPN IPSec site connections
+	// Get VPN IPSec site connections
 	allPages, err := siteconnections.List(c.networkClient, siteconnections.ListOpts{}).AllPages()
 	if err != nil {
 		return []models.Resource{}, fmt.Errorf("failed to list VPN site connections: %w", err)
@@ -988,18 +1281,18 @@ func (c *Client) getVPNConnections(projectNames map[string]string) ([]models.Res
 			Status:      conn.Status,
 			CreatedAt:   created,
 			Properties: models.VPNService{
-				ID:              conn.ID,
-				Name:            name,
-				Description:     conn.Description,
-				Status:          conn.Status,
-				RouterID:        conn.VPNServiceID, // Link to parent VPN service
-				SubnetID:        "",                // Not available in site connection
-				PeerID:          conn.PeerID,
-				PeerAddress:     conn.PeerAddress,
-				AuthMode:        conn.AuthMode,
-				IKEVersion:      "",               // Available in IKE policy, not connection
-				MTU:             conn.MTU,
-				CreatedAt:       created,
+				ID:          conn.ID,
+				Name:        name,
+				Description: conn.Description,
+				Status:      conn.Status,
+				RouterID:    conn.VPNServiceID, // Link to parent VPN service
+				SubnetID:    "",                // Not available in site connection
+				PeerID:      conn.PeerID,
+				PeerAddress: conn.PeerAddress,
+				AuthMode:    conn.AuthMode,
+				IKEVersion:  "", // Available in IKE policy, not connection
+				MTU:         conn.MTU,
+				CreatedAt:   created,
 			},
 		})
 	}
@@ -1012,9 +1305,127 @@ func (c *Client) getClusters(projectNames map[string]string) ([]models.Resource,
 		return []models.Resource{}, nil
 	}
 
-	// Note: This is a placeholder as container infra API might vary
-	// Implementation depends on the specific OpenStack deployment
-	return []models.Resource{}, nil
+	// Get current project info for fallback
+	currentProject, _ := c.getCurrentProject()
+
+	allPages, err := clusters.ListDetail(c.containerClient, nil).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterList, err := clusters.ExtractClusters(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(clusterList) == 0 {
+		return []models.Resource{}, nil
+	}
+
+	templateNames := c.getClusterTemplateNames()
+
+	var resources []models.Resource
+	for _, cluster := range clusterList {
+		created := cluster.CreatedAt
+		updated := cluster.UpdatedAt
+
+		// Get project name, fallback to current project if not found
+		projectName := projectNames[cluster.ProjectID]
+		projectID := cluster.ProjectID
+		if projectName == "" {
+			projectName = currentProject.Name
+			projectID = currentProject.ID
+		}
+
+		resources = append(resources, models.Resource{
+			ID:          cluster.UUID,
+			Name:        cluster.Name,
+			Type:        "cluster",
+			ProjectID:   projectID,
+			ProjectName: projectName,
+			Status:      cluster.Status,
+			CreatedAt:   created,
+			UpdatedAt:   updated,
+			Properties: models.Cluster{
+				ID:                  cluster.UUID,
+				Name:                cluster.Name,
+				Status:              cluster.Status,
+				StatusReason:        cluster.StatusReason,
+				ClusterTemplateID:   cluster.ClusterTemplateID,
+				ClusterTemplateName: templateNames[cluster.ClusterTemplateID],
+				COEVersion:          cluster.COEVersion,
+				APIAddress:          cluster.APIAddress,
+				NodeCount:           cluster.NodeCount,
+				MasterCount:         cluster.MasterCount,
+				KeyPair:             cluster.KeyPair,
+				NodeGroups:          c.getClusterNodeGroups(cluster.UUID),
+				CreatedAt:           created,
+				UpdatedAt:           updated,
+			},
+		})
+	}
+
+	return resources, nil
+}
+
+// getClusterTemplateNames returns cluster template names by ID
+func (c *Client) getClusterTemplateNames() map[string]string {
+	names := make(map[string]string)
+
+	allPages, err := clustertemplates.List(c.containerClient, nil).AllPages()
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to list cluster templates: %v\n", err)
+		return names
+	}
+
+	templateList, err := clustertemplates.ExtractClusterTemplates(allPages)
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to extract cluster templates: %v\n", err)
+		return names
+	}
+
+	for _, template := range templateList {
+		names[template.UUID] = template.Name
+	}
+
+	return names
+}
+
+// getClusterNodeGroups gets node groups of a Magnum cluster
+func (c *Client) getClusterNodeGroups(clusterID string) []models.ClusterNodeGroup {
+	// Node groups API requires container-infra microversion 1.9,
+	// use a copy so the shared client keeps its default version
+	nodeGroupClient := *c.containerClient
+	nodeGroupClient.Microversion = "1.9"
+
+	allPages, err := nodegroups.List(&nodeGroupClient, clusterID, nil).AllPages()
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to get node groups for cluster %s: %v\n", clusterID, err)
+		return []models.ClusterNodeGroup{}
+	}
+
+	nodeGroupList, err := nodegroups.ExtractNodeGroups(allPages)
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to extract node groups for cluster %s: %v\n", clusterID, err)
+		return []models.ClusterNodeGroup{}
+	}
+
+	var result []models.ClusterNodeGroup
+	for _, nodeGroup := range nodeGroupList {
+		result = append(result, models.ClusterNodeGroup{
+			ID:           nodeGroup.UUID,
+			Name:         nodeGroup.Name,
+			Role:         nodeGroup.Role,
+			Status:       nodeGroup.Status,
+			FlavorID:     nodeGroup.FlavorID,
+			NodeCount:    nodeGroup.NodeCount,
+			MinNodeCount: nodeGroup.MinNodeCount,
+			MaxNodeCount: nodeGroup.MaxNodeCount,
+			IsDefault:    nodeGroup.IsDefault,
+		})
+	}
+
+	return result
 }
 
 func (c *Client) calculateSummary(resources []models.Resource, totalProjects int) models.Summary {
@@ -1023,6 +1434,17 @@ func (c *Client) calculateSummary(resources []models.Resource, totalProjects int
 	}
 
 	for _, resource := range resources {
+		// Count by region and type
+		if resource.Region != "" {
+			if summary.ByRegion == nil {
+				summary.ByRegion = make(map[string]map[string]int)
+			}
+			if summary.ByRegion[resource.Region] == nil {
+				summary.ByRegion[resource.Region] = make(map[string]int)
+			}
+			summary.ByRegion[resource.Region][resource.Type]++
+		}
+
 		switch resource.Type {
 		case "server":
 			summary.TotalServers++
@@ -1040,6 +1462,27 @@ func (c *Client) calculateSummary(resources []models.Resource, totalProjects int
 			summary.TotalRouters++
 		case "network":
 			summary.TotalNetworks++
+		case "image":
+			summary.TotalImages++
+		case "security_group":
+			summary.TotalSecurityGroups++
+		case "volume_snapshot":
+			summary.TotalVolumeSnapshots++
+		case "volume_backup":
+			summary.TotalVolumeBackups++
+		case "port":
+			summary.TotalPorts++
+		case "container":
+			summary.TotalContainers++
+			summary.AddContainer(resource)
+		case "object_account":
+			summary.AddObjectAccount(resource)
+		case "dns_zone":
+			summary.TotalDNSZones++
+		case "dns_recordset":
+			summary.TotalDNSRecordsets++
+		case "stack":
+			summary.TotalStacks++
 		}
 	}
 
@@ -1103,17 +1546,15 @@ func (c *Client) getFlavorDetails(flavorRef interface{}) (string, string) {
 		return "Unknown", ""
 	}
 
-	// Get flavor details from API
-	flavor, err := flavors.Get(c.computeClient, flavorID).Extract()
+	// Resolve flavor name from the run cache
+	flavorName, err := c.lookupFlavorName(flavorID)
 	if err != nil {
 		return "Unknown", flavorID
 	}
 
-	return flavor.Name, flavorID
+	return flavorName, flavorID
 }
 
-
-
 // getVolumeAttachments gets detailed attachment information including server names
 func (c *Client) getVolumeAttachments(attachments interface{}) []models.VolumeAttachment {
 	var result []models.VolumeAttachment
@@ -1167,12 +1608,12 @@ func (c *Client) getServerName(serverID string) string {
 		return ""
 	}
 
-	server, err := servers.Get(c.computeClient, serverID).Extract()
+	serverName, err := c.lookupServerName(serverID)
 	if err != nil {
 		return serverID // Return ID if can't get name
 	}
 
-	return server.Name
+	return serverName
 }
 
 // getAttachedResourceName gets the name of resource attached to a port
@@ -1181,7 +1622,7 @@ func (c *Client) getAttachedResourceName(portID string) string {
 		return ""
 	}
 
-	port, err := ports.Get(c.networkClient, portID).Extract()
+	port, err := c.lookupPort(portID)
 	if err != nil {
 		return ""
 	}
@@ -1256,56 +1697,33 @@ func (c *Client) getProjectsViaAPI() ([]models.Project, error) {
 
 // createDomainScopedClient creates a domain-scoped OpenStack client for project listing
 func (c *Client) createDomainScopedClient() (*Client, error) {
-	opts := gophercloud.AuthOptions{
-		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
-		Username:         os.Getenv("OS_USERNAME"),
-		Password:         os.Getenv("OS_PASSWORD"),
-		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
-		// No TenantName = domain-scoped token
-		Scope: &gophercloud.AuthScope{
-			DomainName: os.Getenv("OS_USER_DOMAIN_NAME"),
-		},
-	}
-
-	var provider *gophercloud.ProviderClient
-	var err error
-
-	if os.Getenv("OS_INSECURE") == "true" {
-		config := &tls.Config{InsecureSkipVerify: true}
-		transport := &http.Transport{TLSClientConfig: config}
-		client := &http.Client{Transport: transport}
-		provider, err = openstack.NewClient(opts.IdentityEndpoint)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create provider client: %w", err)
-		}
-		provider.HTTPClient = *client
-		err = openstack.Authenticate(provider, opts)
-	} else {
-		provider, err = openstack.AuthenticatedClient(opts)
-	}
-
+	// No project = domain-scoped token (application credentials stay project-scoped)
+	provider, err := c.config.domainProvider(c.ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create domain-scoped authenticated client: %w", err)
 	}
 
-	identityClient, err := openstack.NewIdentityV3(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
+	identityClient, err := openstack.NewIdentityV3(provider, c.config.endpointOpts())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create identity client: %w", err)
 	}
 
-	return &Client{
+	domainClient := &Client{
 		provider:       provider,
 		identityClient: identityClient,
+		config:         c.config,
+		cache:          newLookupCache(),
 		// Only identity client needed for project listing
-	}, nil
+	}
+	domainClient.bindContext(c.ctx)
+
+	return domainClient, nil
 }
 
 // getProjectsViaCommand gets project list using OpenStack CLI (fallback method)
-func getProjectsViaCommand() ([]models.Project, error) {
+func getProjectsViaCommand(ctx context.Context) ([]models.Project, error) {
 	// Use openstack CLI to get project list (works even without identity:list_projects API permission)
-	cmd := exec.Command("openstack", "project", "list", "-f", "json")
+	cmd := exec.CommandContext(ctx, "openstack", "project", "list", "-f", "json")
 
 	// Set environment variables for the command
 	cmd.Env = os.Environ()
@@ -1350,263 +1768,119 @@ func getProjectsViaCommand() ([]models.Project, error) {
 	return result, nil
 }
 
-// getResourcesForProject creates a new client for specific project and gets its resources
-func getResourcesForProject(project models.Project) ([]models.Resource, error) {
+// getResourcesForProject creates a new client for specific project and gets its resources and quotas
+func (c *Client) getResourcesForProject(project models.Project) ([]models.Resource, []models.ProjectQuota, error) {
 	// Create a new client specifically for this project
-	projectClient, err := createClientForProject(project.Name)
+	projectClient, err := createClientForProject(c.ctx, c.config, project)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create client for project %s: %w", project.Name, err)
+		return nil, nil, fmt.Errorf("failed to create client for project %s: %w", project.Name, err)
 	}
+	projectClient.cache = c.cache
+	projectClient.runProjects = c.runProjects
 
-	var resources []models.Resource
 	projectNames := make(map[string]string)
 	projectNames[project.ID] = project.Name
 
-	// Get all resource types for this project with detailed logging
-	fmt.Printf("   📋 Collecting servers...")
-	serverResources, err := projectClient.getServersForSingleProject(projectNames)
-	if err == nil {
-		resources = append(resources, serverResources...)
-		fmt.Printf(" %d found\n", len(serverResources))
-	} else {
-		fmt.Printf(" failed: %v\n", err)
-	}
-
-	fmt.Printf("   💾 Collecting volumes...")
-	volumeResources, err := projectClient.getVolumesForSingleProject(projectNames)
-	if err == nil {
-		resources = append(resources, volumeResources...)
-		fmt.Printf(" %d found\n", len(volumeResources))
-	} else {
-		fmt.Printf(" failed: %v\n", err)
-	}
-
-	fmt.Printf("   🌐 Collecting floating IPs...")
-	floatingIPResources, err := projectClient.getFloatingIPs(projectNames)
-	if err == nil {
-		resources = append(resources, floatingIPResources...)
-		fmt.Printf(" %d found\n", len(floatingIPResources))
-	} else {
-		fmt.Printf(" failed: %v\n", err)
-	}
-
-	fmt.Printf("   🔀 Collecting routers...")
-	routerResources, err := projectClient.getRouters(projectNames)
-	if err == nil {
-		resources = append(resources, routerResources...)
-		fmt.Printf(" %d found\n", len(routerResources))
-	} else {
-		fmt.Printf(" failed: %v\n", err)
-	}
-
-	fmt.Printf("   🌐 Collecting networks...")
-	networkResources, err := projectClient.getNetworks(projectNames)
-	if err == nil {
-		resources = append(resources, networkResources...)
-		fmt.Printf(" %d found\n", len(networkResources))
-	} else {
-		fmt.Printf(" failed: %v\n", err)
-	}
-
-	if projectClient.loadbalancerClient != nil {
-		fmt.Printf("   ⚖️  Collecting load balancers...")
-		lbResources, err := projectClient.getLoadBalancers(projectNames)
-		if err == nil {
-			resources = append(resources, lbResources...)
-			fmt.Printf(" %d found\n", len(lbResources))
-		} else {
-			fmt.Printf(" failed: %v\n", err)
+	var resources []models.Resource
+	var quotas []models.ProjectQuota
+	for _, region := range projectClient.collectionRegions() {
+		regionClient, err := projectClient.forRegion(region)
+		if err != nil {
+			fmt.Printf("   [%s] %v\n", project.Name, err)
+			continue
 		}
-	}
 
-	fmt.Printf("   🔒 Collecting VPN connections...")
-	vpnResources, err := projectClient.getVPNConnections(projectNames)
-	if err == nil {
-		resources = append(resources, vpnResources...)
-		fmt.Printf(" %d found\n", len(vpnResources))
-	} else {
-		fmt.Printf(" failed: %v\n", err)
-	}
+		// Get all resource types for this project with detailed logging
+		regionResources := regionClient.runCollectors(projectNames, true,
+			func(collector Collector) {},
+			func(collector Collector, collectorResources []models.Resource, err error) {
+				if err != nil {
+					fmt.Printf("   [%s%s] %s failed: %v\n", project.Name, regionSuffix(region), collector.Label(), err)
+					return
+				}
+				fmt.Printf("   [%s%s] %s: %d found\n", project.Name, regionSuffix(region), collector.Label(), len(collectorResources))
+			})
+		resources = append(resources, tagRegion(regionResources, region)...)
 
-	if projectClient.containerClient != nil {
-		fmt.Printf("   ☸️  Collecting K8s clusters...")
-		clusterResources, err := projectClient.getClusters(projectNames)
-		if err == nil {
-			resources = append(resources, clusterResources...)
-			fmt.Printf(" %d found\n", len(clusterResources))
-		} else {
-			fmt.Printf(" failed: %v\n", err)
+		quota, err := regionClient.getProjectQuota(project.ID, project.Name)
+		if err != nil {
+			fmt.Printf("   [%s%s] quotas failed: %v\n", project.Name, regionSuffix(region), err)
+			continue
 		}
+		quotas = append(quotas, quota)
 	}
 
-	return resources, nil
+	return resources, quotas, nil
 }
 
-// getResourcesForProjectWithProgress creates a new client for specific project and gets its resources with progress
-func getResourcesForProjectWithProgress(project models.Project, reporter ProgressReporter) ([]models.Resource, error) {
+// getResourcesForProjectWithProgress creates a new client for specific project and gets its resources and quotas with progress
+func (c *Client) getResourcesForProjectWithProgress(project models.Project, reporter ProgressReporter) ([]models.Resource, []models.ProjectQuota, error) {
 	// Create a new client specifically for this project
-	projectClient, err := createClientForProject(project.Name)
+	projectClient, err := createClientForProject(c.ctx, c.config, project)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create client for project %s: %w", project.Name, err)
+		return nil, nil, fmt.Errorf("failed to create client for project %s: %w", project.Name, err)
 	}
+	projectClient.cache = c.cache
+	projectClient.runProjects = c.runProjects
 
-	var resources []models.Resource
 	projectNames := make(map[string]string)
 	projectNames[project.ID] = project.Name
 
-	// Get all resource types for this project with detailed progress reporting
-	reporter.SendProgress("resource_start", "Collecting servers", 0, 0, project.Name, "servers", 0, nil)
-	serverResources, err := projectClient.getServersForSingleProject(projectNames)
-	if err == nil {
-		resources = append(resources, serverResources...)
-		reporter.SendProgress("resource_complete", "Servers collected", 0, 0, project.Name, "servers", len(serverResources), nil)
-	} else {
-		reporter.SendProgress("resource_error", fmt.Sprintf("Failed to collect servers: %v", err), 0, 0, project.Name, "servers", 0, nil)
-	}
-
-	reporter.SendProgress("resource_start", "Collecting volumes", 0, 0, project.Name, "volumes", 0, nil)
-	volumeResources, err := projectClient.getVolumesForSingleProject(projectNames)
-	if err == nil {
-		resources = append(resources, volumeResources...)
-		reporter.SendProgress("resource_complete", "Volumes collected", 0, 0, project.Name, "volumes", len(volumeResources), nil)
-	} else {
-		reporter.SendProgress("resource_error", fmt.Sprintf("Failed to collect volumes: %v", err), 0, 0, project.Name, "volumes", 0, nil)
-	}
-
-	reporter.SendProgress("resource_start", "Collecting floating IPs", 0, 0, project.Name, "floating_ips", 0, nil)
-	floatingIPResources, err := projectClient.getFloatingIPs(projectNames)
-	if err == nil {
-		resources = append(resources, floatingIPResources...)
-		reporter.SendProgress("resource_complete", "Floating IPs collected", 0, 0, project.Name, "floating_ips", len(floatingIPResources), nil)
-	} else {
-		reporter.SendProgress("resource_error", fmt.Sprintf("Failed to collect floating IPs: %v", err), 0, 0, project.Name, "floating_ips", 0, nil)
-	}
+	var resources []models.Resource
+	var quotas []models.ProjectQuota
+	for _, region := range projectClient.collectionRegions() {
+		regionReporter := reporterForRegion(reporter, region)
 
-	reporter.SendProgress("resource_start", "Collecting routers", 0, 0, project.Name, "routers", 0, nil)
-	routerResources, err := projectClient.getRouters(projectNames)
-	if err == nil {
-		resources = append(resources, routerResources...)
-		reporter.SendProgress("resource_complete", "Routers collected", 0, 0, project.Name, "routers", len(routerResources), nil)
-	} else {
-		reporter.SendProgress("resource_error", fmt.Sprintf("Failed to collect routers: %v", err), 0, 0, project.Name, "routers", 0, nil)
-	}
+		regionClient, err := projectClient.forRegion(region)
+		if err != nil {
+			regionReporter.SendProgress("progress", err.Error(), 0, 0, project.Name, "", 0, nil)
+			continue
+		}
 
-	reporter.SendProgress("resource_start", "Collecting networks", 0, 0, project.Name, "networks", 0, nil)
-	networkResources, err := projectClient.getNetworks(projectNames)
-	if err == nil {
-		resources = append(resources, networkResources...)
-		reporter.SendProgress("resource_complete", "Networks collected", 0, 0, project.Name, "networks", len(networkResources), nil)
-	} else {
-		reporter.SendProgress("resource_error", fmt.Sprintf("Failed to collect networks: %v", err), 0, 0, project.Name, "networks", 0, nil)
-	}
+		// Every resource type sends resource_start followed by resource_complete or resource_error
+		onStart, onDone := collectorProgress(regionReporter, project.Name)
+		regionResources := regionClient.runCollectors(projectNames, true, onStart, onDone)
+		resources = append(resources, tagRegion(regionResources, region)...)
 
-	// Always send load balancer progress (even if client is nil)
-	reporter.SendProgress("resource_start", "Collecting load balancers", 0, 0, project.Name, "load_balancers", 0, nil)
-	if projectClient.loadbalancerClient != nil {
-		lbResources, err := projectClient.getLoadBalancers(projectNames)
-		if err == nil {
-			resources = append(resources, lbResources...)
-			reporter.SendProgress("resource_complete", "Load balancers collected", 0, 0, project.Name, "load_balancers", len(lbResources), nil)
-		} else {
-			reporter.SendProgress("resource_error", fmt.Sprintf("Failed to collect load balancers: %v", err), 0, 0, project.Name, "load_balancers", 0, nil)
+		regionReporter.SendProgress("resource_start", "Collecting quotas", 0, 0, project.Name, "quotas", 0, nil)
+		quota, err := regionClient.getProjectQuota(project.ID, project.Name)
+		if err != nil {
+			regionReporter.SendProgress("resource_error", fmt.Sprintf("Failed to collect quotas: %v", err), 0, 0, project.Name, "quotas", 0, nil)
+			continue
 		}
-	} else {
-		reporter.SendProgress("resource_complete", "Load balancers collected", 0, 0, project.Name, "load_balancers", 0, nil)
+		quotas = append(quotas, quota)
+		regionReporter.SendProgress("resource_complete", "Quotas collected", 0, 0, project.Name, "quotas", len(quota.Usages), nil)
 	}
 
-	reporter.SendProgress("resource_start", "Collecting VPN connections", 0, 0, project.Name, "vpn_connections", 0, nil)
-	vpnResources, err := projectClient.getVPNConnections(projectNames)
-	if err == nil {
-		resources = append(resources, vpnResources...)
-		reporter.SendProgress("resource_complete", "VPN connections collected", 0, 0, project.Name, "vpn_connections", len(vpnResources), nil)
-	} else {
-		reporter.SendProgress("resource_error", fmt.Sprintf("Failed to collect VPN connections: %v", err), 0, 0, project.Name, "vpn_connections", 0, nil)
-	}
+	return resources, quotas, nil
+}
 
-	// Always send K8s clusters progress (even if client is nil)
-	reporter.SendProgress("resource_start", "Collecting K8s clusters", 0, 0, project.Name, "k8s_clusters", 0, nil)
-	if projectClient.containerClient != nil {
-		clusterResources, err := projectClient.getClusters(projectNames)
-		if err == nil {
-			resources = append(resources, clusterResources...)
-			reporter.SendProgress("resource_complete", "K8s clusters collected", 0, 0, project.Name, "k8s_clusters", len(clusterResources), nil)
-		} else {
-			reporter.SendProgress("resource_error", fmt.Sprintf("Failed to collect K8s clusters: %v", err), 0, 0, project.Name, "k8s_clusters", 0, nil)
-		}
-	} else {
-		reporter.SendProgress("resource_complete", "K8s clusters collected", 0, 0, project.Name, "k8s_clusters", 0, nil)
+// capitalize upper-cases the first letter of an ASCII label
+func capitalize(s string) string {
+	if s == "" {
+		return s
 	}
-
-	return resources, nil
+	return strings.ToUpper(s[:1]) + s[1:]
 }
 
 // collectResourcesForProjectsWithProgress collects resources using current client with progress (single project mode)
 func (c *Client) collectResourcesForProjectsWithProgress(report *models.ResourceReport, projectNames map[string]string, reporter ProgressReporter) (*models.ResourceReport, error) {
-	// Get all resource types with progress updates
-	reporter.SendProgress("resource_start", "Collecting servers", 0, 0, "", "servers", 0, nil)
-	serverResources, err := c.getServers(projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get servers: %w", err)
-	}
-	report.Resources = append(report.Resources, serverResources...)
-	reporter.SendProgress("resource_complete", "Servers collected", 0, 0, "", "servers", len(serverResources), nil)
-
-	reporter.SendProgress("resource_start", "Collecting volumes", 0, 0, "", "volumes", 0, nil)
-	volumeResources, err := c.getVolumes(projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get volumes: %w", err)
-	}
-	report.Resources = append(report.Resources, volumeResources...)
-	reporter.SendProgress("resource_complete", "Volumes collected", 0, 0, "", "volumes", len(volumeResources), nil)
-
-	reporter.SendProgress("resource_start", "Collecting floating IPs", 0, 0, "", "floating_ips", 0, nil)
-	floatingIPResources, err := c.getFloatingIPs(projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get floating IPs: %w", err)
-	}
-	report.Resources = append(report.Resources, floatingIPResources...)
-	reporter.SendProgress("resource_complete", "Floating IPs collected", 0, 0, "", "floating_ips", len(floatingIPResources), nil)
-
-	reporter.SendProgress("resource_start", "Collecting routers", 0, 0, "", "routers", 0, nil)
-	routerResources, err := c.getRouters(projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get routers: %w", err)
-	}
-	report.Resources = append(report.Resources, routerResources...)
-	reporter.SendProgress("resource_complete", "Routers collected", 0, 0, "", "routers", len(routerResources), nil)
-
-	reporter.SendProgress("resource_start", "Collecting networks", 0, 0, "", "networks", 0, nil)
-	networkResources, err := c.getNetworks(projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get networks: %w", err)
-	}
-	report.Resources = append(report.Resources, networkResources...)
-	reporter.SendProgress("resource_complete", "Networks collected", 0, 0, "", "networks", len(networkResources), nil)
-
-	// Optional services
-	if c.loadbalancerClient != nil {
-		reporter.SendProgress("resource_start", "Collecting load balancers", 0, 0, "", "load_balancers", 0, nil)
-		lbResources, err := c.getLoadBalancers(projectNames)
-		if err == nil {
-			report.Resources = append(report.Resources, lbResources...)
-			reporter.SendProgress("resource_complete", "Load balancers collected", 0, 0, "", "load_balancers", len(lbResources), nil)
+	for _, region := range c.collectionRegions() {
+		// A region that cannot be reached is reported and the others are still collected
+		regionReporter := reporterForRegion(reporter, region)
+		regionClient, err := c.forRegion(region)
+		if err != nil {
+			regionReporter.SendProgress("resource_error", err.Error(), 0, 0, "", "region", 0, nil)
+			continue
 		}
-	}
 
-	reporter.SendProgress("resource_start", "Collecting VPN connections", 0, 0, "", "vpn_connections", 0, nil)
-	vpnResources, err := c.getVPNConnections(projectNames)
-	if err == nil {
-		report.Resources = append(report.Resources, vpnResources...)
-		reporter.SendProgress("resource_complete", "VPN connections collected", 0, 0, "", "vpn_connections", len(vpnResources), nil)
-	}
+		regionResources := regionClient.collectRegionResourcesWithProgress(projectNames, regionReporter)
+		report.Resources = append(report.Resources, tagRegion(regionResources, region)...)
 
-	if c.containerClient != nil {
-		reporter.SendProgress("resource_start", "Collecting K8s clusters", 0, 0, "", "k8s_clusters", 0, nil)
-		clusterResources, err := c.getClusters(projectNames)
-		if err == nil {
-			report.Resources = append(report.Resources, clusterResources...)
-			reporter.SendProgress("resource_complete", "K8s clusters collected", 0, 0, "", "k8s_clusters", len(clusterResources), nil)
-		}
+		regionReporter.SendProgress("resource_start", "Collecting quotas", 0, 0, "", "quotas", 0, nil)
+		quotas := regionClient.getQuotas(projectNames)
+		report.Quotas = append(report.Quotas, quotas...)
+		regionReporter.SendProgress("resource_complete", "Quotas collected", 0, 0, "", "quotas", len(quotas), nil)
 	}
 
 	// Calculate summary
@@ -1615,142 +1889,53 @@ func (c *Client) collectResourcesForProjectsWithProgress(report *models.Resource
 	return report, nil
 }
 
-// createClientForProject creates a new OpenStack client for specific project
-func createClientForProject(projectName string) (*Client, error) {
-	opts := gophercloud.AuthOptions{
-		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
-		Username:         os.Getenv("OS_USERNAME"),
-		Password:         os.Getenv("OS_PASSWORD"),
-		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
-		TenantName:       projectName, // Use specific project name
-	}
-
-	var provider *gophercloud.ProviderClient
-	var err error
-
-	if os.Getenv("OS_INSECURE") == "true" {
-		config := &tls.Config{InsecureSkipVerify: true}
-		transport := &http.Transport{TLSClientConfig: config}
-		client := &http.Client{Transport: transport}
-		provider, err = openstack.NewClient(opts.IdentityEndpoint)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create provider client: %w", err)
-		}
-		provider.HTTPClient = *client
-		err = openstack.Authenticate(provider, opts)
-	} else {
-		provider, err = openstack.AuthenticatedClient(opts)
-	}
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to create authenticated client for project %s: %w", projectName, err)
-	}
-
-	computeClient, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create compute client: %w", err)
-	}
-
-	blockstorageClient, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create block storage client: %w", err)
-	}
-
-	networkClient, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create network client: %w", err)
-	}
+// collectRegionResourcesWithProgress collects resources of the client's region with progress.
+// Resource types that fail are reported with resource_error and left out.
+func (c *Client) collectRegionResourcesWithProgress(projectNames map[string]string, reporter ProgressReporter) []models.Resource {
+	onStart, onDone := collectorProgress(reporter, "")
+	return c.runCollectors(projectNames, false, onStart, onDone)
+}
 
-	identityClient, err := openstack.NewIdentityV3(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create identity client: %w", err)
+// collectorProgress returns runCollectors callbacks sending resource_start followed by
+// resource_complete or resource_error for every collector
+func collectorProgress(reporter ProgressReporter, project string) (func(collector Collector), func(collector Collector, resources []models.Resource, err error)) {
+	onStart := func(collector Collector) {
+		reporter.SendProgress("resource_start", "Collecting "+collector.Label(), 0, 0, project, collector.Name(), 0, nil)
 	}
-
-	loadbalancerClient, err := openstack.NewLoadBalancerV2(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
-	if err != nil {
-		loadbalancerClient = nil
+	onDone := func(collector Collector, resources []models.Resource, err error) {
+		if err != nil {
+			reporter.SendProgress("resource_error", fmt.Sprintf("Failed to collect %s: %v", collector.Label(), err), 0, 0, project, collector.Name(), 0, nil)
+			return
+		}
+		reporter.SendProgress("resource_complete", capitalize(collector.Label())+" collected", 0, 0, project, collector.Name(), len(resources), nil)
 	}
+	return onStart, onDone
+}
 
-	containerClient, err := openstack.NewContainerInfraV1(provider, gophercloud.EndpointOpts{
-		Region: os.Getenv("OS_REGION_NAME"),
-	})
+// createClientForProject creates a new OpenStack client for specific project
+func createClientForProject(ctx context.Context, config *cloudConfig, project models.Project) (*Client, error) {
+	// Rescopes the shared base token instead of sending credentials for every project
+	provider, err := config.projectProvider(ctx, project.ID, project.Name)
 	if err != nil {
-		containerClient = nil
+		return nil, fmt.Errorf("failed to create authenticated client for project %s: %w", project.Name, err)
 	}
 
-	return &Client{
-		provider:           provider,
-		computeClient:      computeClient,
-		blockstorageClient: blockstorageClient,
-		networkClient:      networkClient,
-		identityClient:     identityClient,
-		loadbalancerClient: loadbalancerClient,
-		containerClient:    containerClient,
-	}, nil
+	return newClientFromProvider(ctx, provider, config, firstNonEmpty(project.ID, project.Name))
 }
 
 // collectResourcesForProjects collects resources using current client (single project mode)
 func (c *Client) collectResourcesForProjects(report *models.ResourceReport, projectNames map[string]string) (*models.ResourceReport, error) {
-	// Get all resource types
-	serverResources, err := c.getServers(projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get servers: %w", err)
-	}
-	report.Resources = append(report.Resources, serverResources...)
-
-	volumeResources, err := c.getVolumes(projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get volumes: %w", err)
-	}
-	report.Resources = append(report.Resources, volumeResources...)
-
-	floatingIPResources, err := c.getFloatingIPs(projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get floating IPs: %w", err)
-	}
-	report.Resources = append(report.Resources, floatingIPResources...)
-
-	routerResources, err := c.getRouters(projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get routers: %w", err)
-	}
-	report.Resources = append(report.Resources, routerResources...)
-
-	networkResources, err := c.getNetworks(projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get networks: %w", err)
-	}
-	report.Resources = append(report.Resources, networkResources...)
-
-	// Optional services
-	if c.loadbalancerClient != nil {
-		lbResources, err := c.getLoadBalancers(projectNames)
-		if err == nil {
-			report.Resources = append(report.Resources, lbResources...)
+	for _, region := range c.collectionRegions() {
+		// A region that cannot be reached is logged and the others are still collected
+		regionClient, err := c.forRegion(region)
+		if err != nil {
+			fmt.Printf("DEBUG: Skipping region %s: %v\n", region, err)
+			continue
 		}
-	}
 
-	// Get VPN IPSec Site Connections (actual VPN tunnels with peer info)
-	vpnResources, err := c.getVPNConnections(projectNames)
-	if err == nil {
-		report.Resources = append(report.Resources, vpnResources...)
-	}
-
-	if c.containerClient != nil {
-		clusterResources, err := c.getClusters(projectNames)
-		if err == nil {
-			report.Resources = append(report.Resources, clusterResources...)
-		}
+		regionResources := regionClient.collectRegionResources(projectNames)
+		report.Resources = append(report.Resources, tagRegion(regionResources, region)...)
+		report.Quotas = append(report.Quotas, regionClient.getQuotas(projectNames)...)
 	}
 
 	// Calculate summary
@@ -1759,11 +1944,22 @@ func (c *Client) collectResourcesForProjects(report *models.ResourceReport, proj
 	return report, nil
 }
 
+// collectRegionResources collects resources of the client's region. Resource types that fail are logged and left out.
+func (c *Client) collectRegionResources(projectNames map[string]string) []models.Resource {
+	return c.runCollectors(projectNames, false,
+		func(collector Collector) {},
+		func(collector Collector, resources []models.Resource, err error) {
+			if err != nil {
+				fmt.Printf("DEBUG: Failed to collect %s: %v\n", collector.Label(), err)
+			}
+		})
+}
+
 // getServersForSingleProject gets servers without AllTenants (for per-project clients)
 func (c *Client) getServersForSingleProject(projectNames map[string]string) ([]models.Resource, error) {
 	// Always use project-scoped request (no AllTenants)
 	listOpts := servers.ListOpts{}
-	allPages, err := servers.List(c.computeClient, listOpts).AllPages()
+	allPages, err := c.listServers(listOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -1772,6 +1968,8 @@ func (c *Client) getServersForSingleProject(projectNames map[string]string) ([]m
 	if err != nil {
 		return nil, err
 	}
+	c.seedServers(serverList, true)
+	attributes := extractServerAttributes(allPages)
 
 	// Get the project name from the first entry in projectNames map
 	var fallbackProjectName, fallbackProjectID string
@@ -1794,8 +1992,6 @@ func (c *Client) getServersForSingleProject(projectNames map[string]string) ([]m
 			projectID = fallbackProjectID
 		}
 
-		flavorName, flavorID := c.getFlavorDetails(server.Flavor)
-
 		resources = append(resources, models.Resource{
 			ID:          server.ID,
 			Name:        server.Name,
@@ -1805,16 +2001,7 @@ func (c *Client) getServersForSingleProject(projectNames map[string]string) ([]m
 			Status:      server.Status,
 			CreatedAt:   created,
 			UpdatedAt:   updated,
-			Properties: models.Server{
-				ID:         server.ID,
-				Name:       server.Name,
-				Status:     server.Status,
-				FlavorName: flavorName,
-				FlavorID:   flavorID,
-				Networks:   extractNetworks(server.Addresses),
-				CreatedAt:  created,
-				UpdatedAt:  updated,
-			},
+			Properties:  c.serverProperties(server, attributes[server.ID]),
 		})
 	}
 
@@ -0,0 +1,46 @@
+package openstack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// newTestClient returns a client without token whose service clients all point at a fake
+// API served by handler. The client lists all tenants, like an admin's main client.
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	provider := &gophercloud.ProviderClient{HTTPClient: *server.Client()}
+	serviceClient := func() *gophercloud.ServiceClient {
+		return &gophercloud.ServiceClient{ProviderClient: provider, Endpoint: server.URL + "/"}
+	}
+	return &Client{
+		provider:            provider,
+		computeClient:       serviceClient(),
+		blockstorageClient:  serviceClient(),
+		networkClient:       serviceClient(),
+		identityClient:      serviceClient(),
+		loadbalancerClient:  serviceClient(),
+		imageClient:         serviceClient(),
+		objectClient:        serviceClient(),
+		dnsClient:           serviceClient(),
+		orchestrationClient: serviceClient(),
+		config:              &cloudConfig{},
+		cache:               newLookupCache(),
+		ctx:                 context.Background(),
+	}
+}
+
+// jsonHandler serves body as JSON
+func jsonHandler(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}
+}
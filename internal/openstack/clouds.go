@@ -0,0 +1,60 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"openstack-reporter/internal/models"
+)
+
+// CloudNames returns the clouds.yaml entries listed in OS_CLOUDS, collected into one report.
+// It is empty when a single cloud is configured through OS_CLOUD or OS_* variables.
+func CloudNames() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(os.Getenv("OS_CLOUDS"), ",") {
+		name = strings.TrimSpace(name)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// NewClientForCloud creates a client for the named clouds.yaml entry whose requests are bound to ctx
+func NewClientForCloud(ctx context.Context, cloudName string) (*Client, error) {
+	config, err := cloudConfigFromFile(cloudName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration of cloud %s: %w", cloudName, err)
+	}
+
+	return newClient(ctx, config)
+}
+
+// CloudName returns the clouds.yaml entry of the client, empty for environment configuration
+func (c *Client) CloudName() string {
+	return c.config.Name
+}
+
+// tagCloud sets the cloud of every project, resource, quota, capacity and access entry of the report
+func tagCloud(report *models.ResourceReport, cloud string) *models.ResourceReport {
+	for i := range report.Projects {
+		report.Projects[i].Cloud = cloud
+	}
+	for i := range report.Resources {
+		report.Resources[i].Cloud = cloud
+	}
+	for i := range report.Quotas {
+		report.Quotas[i].Cloud = cloud
+	}
+	for i := range report.Capacity {
+		report.Capacity[i].Cloud = cloud
+	}
+	for i := range report.Access {
+		report.Access[i].Cloud = cloud
+	}
+	return report
+}
@@ -0,0 +1,204 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/gophercloud/gophercloud"
+
+	"openstack-reporter/internal/models"
+)
+
+// Collector collects the resources of one type from the service it requires
+type Collector interface {
+	// Name is the key used in progress events and in COLLECTORS, e.g. "floating_ips"
+	Name() string
+	// Label is the human readable name, e.g. "floating IPs"
+	Label() string
+	// Service is the catalog type of the service the collector needs, e.g. "network"
+	Service() string
+	// Collect lists the resources visible to the client. projectScoped is set for the
+	// project-scoped clients of a per-project collection.
+	Collect(c *Client, projectNames map[string]string, projectScoped bool) ([]models.Resource, error)
+}
+
+// CollectFunc lists resources of a client
+type CollectFunc func(c *Client, projectNames map[string]string) ([]models.Resource, error)
+
+// resourceCollector is a Collector built from collect functions
+type resourceCollector struct {
+	name    string
+	label   string
+	service string
+	collect CollectFunc
+	// collectProject replaces collect for project-scoped clients when set
+	collectProject CollectFunc
+}
+
+func (r resourceCollector) Name() string    { return r.name }
+func (r resourceCollector) Label() string   { return r.label }
+func (r resourceCollector) Service() string { return r.service }
+
+func (r resourceCollector) Collect(c *Client, projectNames map[string]string, projectScoped bool) ([]models.Resource, error) {
+	if projectScoped && r.collectProject != nil {
+		return r.collectProject(c, projectNames)
+	}
+	return r.collect(c, projectNames)
+}
+
+// NewCollector returns a Collector calling collect
+func NewCollector(name, label, service string, collect CollectFunc) Collector {
+	return resourceCollector{name: name, label: label, service: service, collect: collect}
+}
+
+// collectors is the registry of resource collectors, in report order
+var collectors = []Collector{
+	resourceCollector{name: "servers", label: "servers", service: "compute",
+		collect: (*Client).getServers, collectProject: (*Client).getServersForSingleProject},
+	resourceCollector{name: "volumes", label: "volumes", service: "block-storage",
+		collect: (*Client).getVolumes, collectProject: (*Client).getVolumesForSingleProject},
+	resourceCollector{name: "volume_snapshots", label: "volume snapshots", service: "block-storage", collect: (*Client).getVolumeSnapshots},
+	// The backup API is missing when cinder-backup isn't deployed
+	resourceCollector{name: "volume_backups", label: "volume backups", service: "block-storage", collect: (*Client).getVolumeBackups},
+	resourceCollector{name: "floating_ips", label: "floating IPs", service: "network", collect: (*Client).getFloatingIPs},
+	resourceCollector{name: "routers", label: "routers", service: "network", collect: (*Client).getRouters},
+	resourceCollector{name: "networks", label: "networks", service: "network", collect: (*Client).getNetworks},
+	resourceCollector{name: "load_balancers", label: "load balancers", service: "load-balancer", collect: (*Client).getLoadBalancers},
+	// VPN IPSec site connections are the actual tunnels with peer info
+	resourceCollector{name: "vpn_connections", label: "VPN connections", service: "network", collect: (*Client).getVPNConnections},
+	resourceCollector{name: "k8s_clusters", label: "K8s clusters", service: "container-infra", collect: (*Client).getClusters},
+	resourceCollector{name: "security_groups", label: "security groups", service: "network", collect: (*Client).getSecurityGroups},
+	resourceCollector{name: "ports", label: "ports", service: "network", collect: (*Client).getPorts},
+	resourceCollector{name: "images", label: "images", service: "image", collect: (*Client).getImages},
+	resourceCollector{name: "containers", label: "object storage containers", service: "object-store", collect: (*Client).getContainers},
+	resourceCollector{name: "dns_zones", label: "DNS zones", service: "dns", collect: (*Client).getDNSZones},
+	resourceCollector{name: "stacks", label: "stacks", service: "orchestration", collect: (*Client).getStacks},
+}
+
+// collectorsMu guards collectors against registrations while collections read the registry
+var collectorsMu sync.RWMutex
+
+// RegisterCollector adds a collector after the built-in ones. Collections that already started
+// don't run it.
+func RegisterCollector(collector Collector) {
+	collectorsMu.Lock()
+	defer collectorsMu.Unlock()
+	collectors = append(collectors, collector)
+}
+
+// registeredCollectors returns a snapshot of the registry
+func registeredCollectors() []Collector {
+	collectorsMu.RLock()
+	defer collectorsMu.RUnlock()
+	return append([]Collector(nil), collectors...)
+}
+
+// serviceClient returns the client of a catalog service type, nil when the service is unavailable
+func (c *Client) serviceClient(service string) *gophercloud.ServiceClient {
+	switch service {
+	case "compute":
+		return c.computeClient
+	case "block-storage":
+		return c.blockstorageClient
+	case "network":
+		return c.networkClient
+	case "identity":
+		return c.identityClient
+	case "load-balancer":
+		return c.loadbalancerClient
+	case "container-infra":
+		return c.containerClient
+	case "image":
+		return c.imageClient
+	case "object-store":
+		return c.objectClient
+	case "dns":
+		return c.dnsClient
+	case "orchestration":
+		return c.orchestrationClient
+	}
+	return nil
+}
+
+// enabledCollectors returns the registered collectors selected by COLLECTORS, a comma-separated
+// list of collector names, minus those in COLLECTORS_DISABLED. All are selected when COLLECTORS is unset.
+func enabledCollectors() []Collector {
+	registered := registeredCollectors()
+	enabled := collectorNames("COLLECTORS", registered)
+	disabled := collectorNames("COLLECTORS_DISABLED", registered)
+
+	var selected []Collector
+	for _, collector := range registered {
+		if len(enabled) > 0 && !enabled[collector.Name()] {
+			continue
+		}
+		if disabled[collector.Name()] {
+			continue
+		}
+		selected = append(selected, collector)
+	}
+	return selected
+}
+
+// collectorNames reads a comma-separated collector list from envName, warning about names not in registered
+func collectorNames(envName string, registered []Collector) map[string]bool {
+	names := make(map[string]bool)
+	for _, name := range strings.Split(os.Getenv(envName), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		known := false
+		for _, collector := range registered {
+			if collector.Name() == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			fmt.Printf("DEBUG: Unknown collector %q in %s\n", name, envName)
+		}
+		names[name] = true
+	}
+	return names
+}
+
+// runCollectors runs the enabled collectors whose service is available to the client in parallel
+// and returns their resources concatenated in registry order. onStart and onDone may be called
+// concurrently from the worker goroutines. A failed collector only leaves out its resources,
+// the failure is reported through onDone. Collectors whose service is missing from the catalog
+// are reported as done with no resources, so every enabled type shows up in progress.
+func (c *Client) runCollectors(projectNames map[string]string, projectScoped bool, onStart func(collector Collector), onDone func(collector Collector, resources []models.Resource, err error)) []models.Resource {
+	var available []Collector
+	for _, collector := range enabledCollectors() {
+		if c.serviceClient(collector.Service()) == nil {
+			onStart(collector)
+			onDone(collector, nil, nil)
+			continue
+		}
+		available = append(available, collector)
+	}
+
+	results := make([][]models.Resource, len(available))
+
+	runBounded(len(available), getConcurrencyLimit("RESOURCE_CONCURRENCY", defaultResourceConcurrency), func(i int) {
+		collector := available[i]
+		if c.ctx.Err() != nil {
+			return // The refresh was canceled or timed out, skip the remaining collectors
+		}
+		onStart(collector)
+		collectorResources, err := collector.Collect(c, projectNames, projectScoped)
+		onDone(collector, collectorResources, err)
+		if err == nil {
+			results[i] = collectorResources
+		}
+	})
+
+	var resources []models.Resource
+	for _, collectorResources := range results {
+		resources = append(resources, collectorResources...)
+	}
+	return resources
+}
@@ -0,0 +1,68 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// defaultProjectConcurrency is the number of projects collected in parallel
+	defaultProjectConcurrency = 4
+	// defaultResourceConcurrency is the number of resource types collected in parallel within a project
+	defaultResourceConcurrency = 4
+	// defaultContainerConcurrency is the number of Swift containers whose ACLs are read in parallel
+	defaultContainerConcurrency = 4
+)
+
+// getConcurrencyLimit reads a positive worker count from the environment, falling back to defaultValue
+func getConcurrencyLimit(envName string, defaultValue int) int {
+	value := strings.TrimSpace(os.Getenv(envName))
+	if value == "" {
+		return defaultValue
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 {
+		fmt.Printf("DEBUG: Invalid %s value %q, using default %d\n", envName, value, defaultValue)
+		return defaultValue
+	}
+
+	return limit
+}
+
+// runBounded calls fn for every index in [0, n) using at most limit goroutines.
+// Indexes are handed out in ascending order and runBounded returns once all calls have finished.
+func runBounded(n, limit int, fn func(i int)) {
+	if n == 0 {
+		return
+	}
+	if limit > n {
+		limit = n
+	}
+	if limit < 1 {
+		limit = 1
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < limit; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg.Wait()
+}
@@ -0,0 +1,301 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"gopkg.in/yaml.v3"
+)
+
+// cloudConfig holds connection settings for one OpenStack cloud,
+// read either from a clouds.yaml entry (OS_CLOUD) or from OS_* environment variables
+type cloudConfig struct {
+	Name string // clouds.yaml entry name, empty for environment configuration
+
+	AuthURL  string
+	AuthType string
+
+	Username string
+	UserID   string
+	Password string
+
+	UserDomainName    string
+	UserDomainID      string
+	ProjectDomainName string
+	ProjectDomainID   string
+
+	ProjectName string
+	ProjectID   string
+
+	ApplicationCredentialID     string
+	ApplicationCredentialName   string
+	ApplicationCredentialSecret string
+
+	RegionName string
+	Regions    []string // regions collected in one refresh, or ["auto"] for catalog discovery
+	Interface  string   // public, internal or admin
+	Insecure   bool
+	CACert     string
+	RateLimit  float64 // requests per second to the cloud, zero for no limit
+}
+
+// cloudsFile mirrors the top level of clouds.yaml and secure.yaml
+type cloudsFile struct {
+	Clouds map[string]map[string]interface{} `yaml:"clouds"`
+}
+
+// cloudEntry mirrors a single clouds.yaml entry after secure.yaml has been merged in
+type cloudEntry struct {
+	Auth struct {
+		AuthURL                     string `yaml:"auth_url"`
+		Username                    string `yaml:"username"`
+		UserID                      string `yaml:"user_id"`
+		Password                    string `yaml:"password"`
+		ProjectName                 string `yaml:"project_name"`
+		ProjectID                   string `yaml:"project_id"`
+		UserDomainName              string `yaml:"user_domain_name"`
+		UserDomainID                string `yaml:"user_domain_id"`
+		ProjectDomainName           string `yaml:"project_domain_name"`
+		ProjectDomainID             string `yaml:"project_domain_id"`
+		DomainName                  string `yaml:"domain_name"`
+		DomainID                    string `yaml:"domain_id"`
+		ApplicationCredentialID     string `yaml:"application_credential_id"`
+		ApplicationCredentialName   string `yaml:"application_credential_name"`
+		ApplicationCredentialSecret string `yaml:"application_credential_secret"`
+	} `yaml:"auth"`
+	AuthType   string        `yaml:"auth_type"`
+	RegionName string        `yaml:"region_name"`
+	Regions    []interface{} `yaml:"regions"`
+	Interface  string        `yaml:"interface"`
+	Verify     *bool         `yaml:"verify"`
+	CACert     string        `yaml:"cacert"`
+	RateLimit  *float64      `yaml:"api_rate_limit"`
+}
+
+// loadCloudConfig returns the configuration selected by OS_CLOUD, or the OS_* environment when it is unset
+func loadCloudConfig() (*cloudConfig, error) {
+	cloudName := strings.TrimSpace(os.Getenv("OS_CLOUD"))
+	if cloudName == "" {
+		return cloudConfigFromEnv(), nil
+	}
+	return cloudConfigFromFile(cloudName)
+}
+
+// cloudConfigFromEnv builds the configuration from OS_* environment variables
+func cloudConfigFromEnv() *cloudConfig {
+	config := &cloudConfig{
+		AuthURL:                     os.Getenv("OS_AUTH_URL"),
+		AuthType:                    os.Getenv("OS_AUTH_TYPE"),
+		Username:                    os.Getenv("OS_USERNAME"),
+		UserID:                      os.Getenv("OS_USER_ID"),
+		Password:                    os.Getenv("OS_PASSWORD"),
+		UserDomainName:              os.Getenv("OS_USER_DOMAIN_NAME"),
+		UserDomainID:                os.Getenv("OS_USER_DOMAIN_ID"),
+		ProjectDomainName:           os.Getenv("OS_PROJECT_DOMAIN_NAME"),
+		ProjectDomainID:             os.Getenv("OS_PROJECT_DOMAIN_ID"),
+		ProjectName:                 strings.TrimSpace(os.Getenv("OS_PROJECT_NAME")),
+		ProjectID:                   strings.TrimSpace(os.Getenv("OS_PROJECT_ID")),
+		ApplicationCredentialID:     os.Getenv("OS_APPLICATION_CREDENTIAL_ID"),
+		ApplicationCredentialName:   os.Getenv("OS_APPLICATION_CREDENTIAL_NAME"),
+		ApplicationCredentialSecret: os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET"),
+		RegionName:                  os.Getenv("OS_REGION_NAME"),
+		Regions:                     regionsFromEnv(),
+		Interface:                   firstNonEmpty(os.Getenv("OS_INTERFACE"), os.Getenv("OS_ENDPOINT_TYPE")),
+		Insecure:                    os.Getenv("OS_INSECURE") == "true",
+		CACert:                      os.Getenv("OS_CACERT"),
+		RateLimit:                   rateLimitFromEnv(),
+	}
+	config.defaultRegionName()
+
+	return config
+}
+
+// cloudConfigFromFile reads the named entry from clouds.yaml, merged with secure.yaml if present
+func cloudConfigFromFile(cloudName string) (*cloudConfig, error) {
+	cloudsPath := findConfigFile("OS_CLIENT_CONFIG_FILE", "clouds.yaml")
+	if cloudsPath == "" {
+		return nil, fmt.Errorf("OS_CLOUD is set to %q but no clouds.yaml was found", cloudName)
+	}
+
+	clouds, err := readCloudsFile(cloudsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, exists := clouds.Clouds[cloudName]
+	if !exists {
+		return nil, fmt.Errorf("cloud %q not found in %s", cloudName, cloudsPath)
+	}
+
+	// secure.yaml usually holds passwords and secrets for the same cloud names
+	if securePath := findConfigFile("OS_CLIENT_SECURE_FILE", "secure.yaml"); securePath != "" {
+		secure, err := readCloudsFile(securePath)
+		if err != nil {
+			return nil, err
+		}
+		if secureEntry, exists := secure.Clouds[cloudName]; exists {
+			entry = mergeYAMLMaps(entry, secureEntry)
+		}
+	}
+
+	raw, err := yaml.Marshal(entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cloud %q: %w", cloudName, err)
+	}
+	var parsed cloudEntry
+	if err := yaml.Unmarshal(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse cloud %q: %w", cloudName, err)
+	}
+
+	fmt.Printf("DEBUG: Using cloud %q from %s\n", cloudName, cloudsPath)
+
+	config := &cloudConfig{
+		Name:                        cloudName,
+		AuthURL:                     parsed.Auth.AuthURL,
+		AuthType:                    parsed.AuthType,
+		Username:                    parsed.Auth.Username,
+		UserID:                      parsed.Auth.UserID,
+		Password:                    parsed.Auth.Password,
+		UserDomainName:              firstNonEmpty(parsed.Auth.UserDomainName, parsed.Auth.DomainName),
+		UserDomainID:                firstNonEmpty(parsed.Auth.UserDomainID, parsed.Auth.DomainID),
+		ProjectDomainName:           firstNonEmpty(parsed.Auth.ProjectDomainName, parsed.Auth.DomainName),
+		ProjectDomainID:             firstNonEmpty(parsed.Auth.ProjectDomainID, parsed.Auth.DomainID),
+		ProjectName:                 parsed.Auth.ProjectName,
+		ProjectID:                   parsed.Auth.ProjectID,
+		ApplicationCredentialID:     parsed.Auth.ApplicationCredentialID,
+		ApplicationCredentialName:   parsed.Auth.ApplicationCredentialName,
+		ApplicationCredentialSecret: parsed.Auth.ApplicationCredentialSecret,
+		RegionName:                  parsed.RegionName,
+		Regions:                     parseRegionList(parsed.Regions),
+		Interface:                   parsed.Interface,
+		CACert:                      parsed.CACert,
+		RateLimit:                   rateLimitFromEnv(),
+	}
+	if parsed.Verify != nil {
+		config.Insecure = !*parsed.Verify
+	}
+	// api_rate_limit of the entry overrides API_RATE_LIMIT for this cloud
+	if parsed.RateLimit != nil {
+		config.RateLimit = *parsed.RateLimit
+	}
+	// OS_REGIONS overrides the regions list of the entry, e.g. to enable discovery
+	if regions := regionsFromEnv(); len(regions) > 0 {
+		config.Regions = regions
+	}
+	config.defaultRegionName()
+
+	return config, nil
+}
+
+// defaultRegionName authenticates in the first listed region when no region name is set
+func (c *cloudConfig) defaultRegionName() {
+	if c.RegionName != "" {
+		return
+	}
+	for _, region := range c.Regions {
+		if !strings.EqualFold(region, regionsAuto) {
+			c.RegionName = region
+			return
+		}
+	}
+}
+
+// parseRegionList reads a clouds.yaml regions list, whose items are names or maps with a name key
+func parseRegionList(items []interface{}) []string {
+	var regions []string
+	for _, item := range items {
+		switch value := item.(type) {
+		case string:
+			regions = append(regions, value)
+		case map[string]interface{}:
+			if name, ok := value["name"].(string); ok && name != "" {
+				regions = append(regions, name)
+			}
+		}
+	}
+	return regions
+}
+
+// findConfigFile returns the explicit path from envName or the first existing standard location of fileName
+func findConfigFile(envName, fileName string) string {
+	if path := strings.TrimSpace(os.Getenv(envName)); path != "" {
+		return path
+	}
+
+	candidates := []string{fileName}
+	if home, err := os.UserHomeDir(); err == nil {
+		candidates = append(candidates, filepath.Join(home, ".config", "openstack", fileName))
+	}
+	candidates = append(candidates, filepath.Join("/etc/openstack", fileName))
+
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// readCloudsFile parses a clouds.yaml or secure.yaml file
+func readCloudsFile(path string) (*cloudsFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var clouds cloudsFile
+	if err := yaml.Unmarshal(data, &clouds); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &clouds, nil
+}
+
+// mergeYAMLMaps returns base with values from override applied recursively
+func mergeYAMLMaps(base, override map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(base))
+	for key, value := range base {
+		result[key] = value
+	}
+	for key, value := range override {
+		baseMap, baseIsMap := result[key].(map[string]interface{})
+		overrideMap, overrideIsMap := value.(map[string]interface{})
+		if baseIsMap && overrideIsMap {
+			result[key] = mergeYAMLMaps(baseMap, overrideMap)
+		} else {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// endpointOpts returns the service catalog lookup options for this cloud
+func (cfg *cloudConfig) endpointOpts() gophercloud.EndpointOpts {
+	opts := gophercloud.EndpointOpts{
+		Region: cfg.RegionName,
+	}
+
+	// Accept both "internal" and legacy "internalURL" spellings
+	switch strings.TrimSuffix(strings.ToLower(cfg.Interface), "url") {
+	case "internal":
+		opts.Availability = gophercloud.AvailabilityInternal
+	case "admin":
+		opts.Availability = gophercloud.AvailabilityAdmin
+	default:
+		opts.Availability = gophercloud.AvailabilityPublic
+	}
+
+	return opts
+}
+
+// firstNonEmpty returns the first non-empty string
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,29 @@
+package openstack
+
+import "testing"
+
+func TestCloudConfigFromEnvRegionName(t *testing.T) {
+	tests := []struct {
+		name       string
+		regionName string
+		regions    string
+		want       string
+	}{
+		{name: "region name only", regionName: "RegionOne", want: "RegionOne"},
+		{name: "region name wins over the list", regionName: "RegionOne", regions: "RegionTwo,RegionThree", want: "RegionOne"},
+		{name: "first listed region", regions: "RegionTwo,RegionThree", want: "RegionTwo"},
+		{name: "auto is skipped", regions: "auto,RegionTwo", want: "RegionTwo"},
+		{name: "auto only", regions: "auto", want: ""},
+		{name: "nothing configured", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OS_REGION_NAME", tt.regionName)
+			t.Setenv("OS_REGIONS", tt.regions)
+			if got := cloudConfigFromEnv().RegionName; got != tt.want {
+				t.Errorf("RegionName = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
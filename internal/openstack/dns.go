@@ -0,0 +1,274 @@
+package openstack
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+
+	"openstack-reporter/internal/models"
+)
+
+// getDNSZones lists Designate zones and the recordsets of every zone
+func (c *Client) getDNSZones(projectNames map[string]string) ([]models.Resource, error) {
+	if c.dnsClient == nil {
+		return []models.Resource{}, nil
+	}
+
+	// Get current project info for fallback
+	currentProject, _ := c.getCurrentProject()
+
+	client := c.dnsListClient()
+	allPages, err := zones.List(client, zones.ListOpts{}).AllPages()
+	if err != nil && client != c.dnsClient {
+		fmt.Printf("DEBUG: Listing DNS zones of all projects failed, falling back to own project: %v\n", err)
+		client = c.dnsClient
+		allPages, err = zones.List(client, zones.ListOpts{}).AllPages()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	zoneList, err := zones.ExtractZones(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []models.Resource
+	for _, zone := range zoneList {
+		projectID, projectName := ownerProject(zone.ProjectID, projectNames, client != c.dnsClient, currentProject)
+
+		recordsetResources, err := c.getDNSRecordsets(client, zone, projectID, projectName)
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to list recordsets of zone %s: %v\n", zone.Name, err)
+		}
+
+		resources = append(resources, models.Resource{
+			ID:          zone.ID,
+			Name:        zone.Name,
+			Type:        "dns_zone",
+			ProjectID:   projectID,
+			ProjectName: projectName,
+			Status:      zone.Status,
+			CreatedAt:   zone.CreatedAt,
+			UpdatedAt:   zone.UpdatedAt,
+			Properties: models.DNSZone{
+				ID:          zone.ID,
+				Name:        zone.Name,
+				Email:       zone.Email,
+				Type:        zone.Type,
+				Status:      zone.Status,
+				TTL:         zone.TTL,
+				Serial:      zone.Serial,
+				Masters:     zone.Masters,
+				Description: zone.Description,
+				Recordsets:  len(recordsetResources),
+				CreatedAt:   zone.CreatedAt,
+				UpdatedAt:   zone.UpdatedAt,
+			},
+		})
+		resources = append(resources, recordsetResources...)
+	}
+
+	return resources, nil
+}
+
+// getDNSRecordsets lists the recordsets of a zone, attributed to the zone's project
+func (c *Client) getDNSRecordsets(client *gophercloud.ServiceClient, zone zones.Zone, projectID, projectName string) ([]models.Resource, error) {
+	allPages, err := recordsets.ListByZone(client, zone.ID, recordsets.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	recordsetList, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []models.Resource
+	for _, recordset := range recordsetList {
+		records := recordset.Records
+		if records == nil {
+			records = []string{}
+		}
+
+		resources = append(resources, models.Resource{
+			ID:          recordset.ID,
+			Name:        recordset.Name,
+			Type:        "dns_recordset",
+			ProjectID:   projectID,
+			ProjectName: projectName,
+			Status:      recordset.Status,
+			CreatedAt:   recordset.CreatedAt,
+			UpdatedAt:   recordset.UpdatedAt,
+			Properties: models.DNSRecordSet{
+				ID:          recordset.ID,
+				Name:        recordset.Name,
+				ZoneID:      zone.ID,
+				ZoneName:    zone.Name,
+				Type:        recordset.Type,
+				Records:     records,
+				TTL:         recordset.TTL,
+				Status:      recordset.Status,
+				Description: recordset.Description,
+				CreatedAt:   recordset.CreatedAt,
+				UpdatedAt:   recordset.UpdatedAt,
+			},
+		})
+	}
+
+	return resources, nil
+}
+
+// dnsListClient returns the DNS client to list with. Designate selects all projects
+// through a header rather than a query parameter, so a copy of the client carries it.
+func (c *Client) dnsListClient() *gophercloud.ServiceClient {
+	if !c.allTenants() {
+		return c.dnsClient
+	}
+	client := *c.dnsClient
+	client.MoreHeaders = map[string]string{"X-Auth-All-Projects": "true"}
+	return &client
+}
+
+// linkDNSNames cross-references DNS records with floating IPs and ports. Floating IPs get the
+// names of A, AAAA and PTR records pointing at them; records pointing at an address of a
+// cloud subnet that no port or floating IP holds get it listed as unallocated.
+func linkDNSNames(resources []models.Resource) {
+	floatingIPs := make(map[string]int)
+	allocated := make(map[string]bool)
+	var subnets []*net.IPNet
+	var recordsetIndexes []int
+
+	for i, resource := range resources {
+		switch properties := resource.Properties.(type) {
+		case models.FloatingIP:
+			floatingIPs[properties.FloatingIP] = i
+			allocated[properties.FloatingIP] = true
+		case models.Port:
+			for _, ip := range properties.FixedIPs {
+				allocated[ip.IPAddress] = true
+			}
+		case models.Network:
+			for _, subnet := range properties.Subnets {
+				if _, cidr, err := net.ParseCIDR(subnet.CIDR); err == nil {
+					subnets = append(subnets, cidr)
+				}
+			}
+		case models.DNSRecordSet:
+			recordsetIndexes = append(recordsetIndexes, i)
+		}
+	}
+	if len(recordsetIndexes) == 0 {
+		return
+	}
+
+	dnsNames := make(map[int]map[string]bool)
+	addName := func(floatingIP string, name string) {
+		i := floatingIPs[floatingIP]
+		if dnsNames[i] == nil {
+			dnsNames[i] = make(map[string]bool)
+		}
+		dnsNames[i][strings.TrimSuffix(name, ".")] = true
+	}
+
+	for _, i := range recordsetIndexes {
+		recordset := resources[i].Properties.(models.DNSRecordSet)
+
+		// Forward records hold addresses, PTR records are named after theirs
+		var addresses []string
+		switch recordset.Type {
+		case "A", "AAAA":
+			addresses = recordset.Records
+		case "PTR":
+			if address := ptrAddress(recordset.Name); address != "" {
+				addresses = []string{address}
+			}
+		default:
+			continue
+		}
+
+		for _, address := range addresses {
+			if _, isFloating := floatingIPs[address]; isFloating {
+				if recordset.Type == "PTR" {
+					for _, name := range recordset.Records {
+						addName(address, name)
+					}
+				} else {
+					addName(address, recordset.Name)
+				}
+				continue
+			}
+			if !allocated[address] && inSubnets(address, subnets) {
+				recordset.UnallocatedAddresses = append(recordset.UnallocatedAddresses, address)
+			}
+		}
+		resources[i].Properties = recordset
+	}
+
+	for i, names := range dnsNames {
+		floatingIP := resources[i].Properties.(models.FloatingIP)
+		for name := range names {
+			floatingIP.DNSNames = append(floatingIP.DNSNames, name)
+		}
+		sort.Strings(floatingIP.DNSNames)
+		resources[i].Properties = floatingIP
+	}
+}
+
+// ptrAddress returns the address a reverse lookup name such as "4.3.2.1.in-addr.arpa." stands for,
+// empty when the name is not a full IPv4 or IPv6 reverse name
+func ptrAddress(name string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+
+	if labels, ok := strings.CutSuffix(name, ".in-addr.arpa"); ok {
+		octets := strings.Split(labels, ".")
+		if len(octets) != 4 {
+			return ""
+		}
+		for i, j := 0, len(octets)-1; i < j; i, j = i+1, j-1 {
+			octets[i], octets[j] = octets[j], octets[i]
+		}
+		if ip := net.ParseIP(strings.Join(octets, ".")); ip != nil {
+			return ip.String()
+		}
+		return ""
+	}
+
+	if labels, ok := strings.CutSuffix(name, ".ip6.arpa"); ok {
+		nibbles := strings.Split(labels, ".")
+		if len(nibbles) != 32 {
+			return ""
+		}
+		var address strings.Builder
+		for i := len(nibbles) - 1; i >= 0; i-- {
+			address.WriteString(nibbles[i])
+			if i > 0 && i%4 == 0 {
+				address.WriteByte(':')
+			}
+		}
+		if ip := net.ParseIP(address.String()); ip != nil {
+			return ip.String()
+		}
+	}
+
+	return ""
+}
+
+// inSubnets reports whether address lies in one of subnets
+func inSubnets(address string, subnets []*net.IPNet) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, subnet := range subnets {
+		if subnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package openstack
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"openstack-reporter/internal/models"
+)
+
+func TestLinkDNSNames(t *testing.T) {
+	network := models.Resource{ID: "net-1", Type: "network", Properties: models.Network{
+		Subnets: []models.Subnet{{ID: "subnet-1", CIDR: "10.0.0.0/24"}},
+	}}
+	port := models.Resource{ID: "port-1", Type: "port", Properties: models.Port{
+		FixedIPs: []models.PortFixedIP{{SubnetID: "subnet-1", IPAddress: "10.0.0.5"}},
+	}}
+	floatingIP := func(address string) models.Resource {
+		return models.Resource{ID: "fip-" + address, Type: "floating_ip", Properties: models.FloatingIP{FloatingIP: address}}
+	}
+	recordset := func(name, recordType string, records ...string) models.Resource {
+		return models.Resource{ID: name + recordType, Type: "dns_recordset", Properties: models.DNSRecordSet{
+			Name: name, Type: recordType, Records: records,
+		}}
+	}
+
+	tests := []struct {
+		name            string
+		resources       []models.Resource
+		wantDNSNames    map[string][]string // floating IP resource ID -> DNS names
+		wantUnallocated map[string][]string // recordset resource ID -> unallocated addresses
+	}{
+		{
+			name:         "A record",
+			resources:    []models.Resource{floatingIP("203.0.113.10"), recordset("www.example.com.", "A", "203.0.113.10")},
+			wantDNSNames: map[string][]string{"fip-203.0.113.10": {"www.example.com"}},
+		},
+		{
+			name:         "AAAA record",
+			resources:    []models.Resource{floatingIP("2001:db8::10"), recordset("v6.example.com.", "AAAA", "2001:db8::10")},
+			wantDNSNames: map[string][]string{"fip-2001:db8::10": {"v6.example.com"}},
+		},
+		{
+			name:         "PTR record",
+			resources:    []models.Resource{floatingIP("203.0.113.10"), recordset("10.113.0.203.in-addr.arpa.", "PTR", "host.example.com.")},
+			wantDNSNames: map[string][]string{"fip-203.0.113.10": {"host.example.com"}},
+		},
+		{
+			name: "names are deduplicated and sorted",
+			resources: []models.Resource{
+				floatingIP("203.0.113.10"),
+				recordset("www.example.com.", "A", "203.0.113.10"),
+				recordset("api.example.com.", "A", "203.0.113.10", "203.0.113.11"),
+				recordset("10.113.0.203.in-addr.arpa.", "PTR", "www.example.com."),
+			},
+			wantDNSNames: map[string][]string{"fip-203.0.113.10": {"api.example.com", "www.example.com"}},
+		},
+		{
+			name:         "other record types are ignored",
+			resources:    []models.Resource{floatingIP("203.0.113.10"), recordset("example.com.", "TXT", "203.0.113.10")},
+			wantDNSNames: map[string][]string{"fip-203.0.113.10": nil},
+		},
+		{
+			name: "unallocated address in a cloud subnet",
+			resources: []models.Resource{
+				network, port,
+				recordset("stale.example.com.", "A", "10.0.0.5", "10.0.0.6", "198.51.100.1"),
+			},
+			wantUnallocated: map[string][]string{"stale.example.com.A": {"10.0.0.6"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources := append([]models.Resource(nil), tt.resources...)
+			linkDNSNames(resources)
+
+			for _, resource := range resources {
+				switch properties := resource.Properties.(type) {
+				case models.FloatingIP:
+					if want := tt.wantDNSNames[resource.ID]; !reflect.DeepEqual(properties.DNSNames, want) {
+						t.Errorf("%s DNS names = %q, want %q", resource.ID, properties.DNSNames, want)
+					}
+				case models.DNSRecordSet:
+					if want := tt.wantUnallocated[resource.ID]; !reflect.DeepEqual(properties.UnallocatedAddresses, want) {
+						t.Errorf("%s unallocated addresses = %q, want %q", resource.ID, properties.UnallocatedAddresses, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPTRAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "10.113.0.203.in-addr.arpa.", want: "203.0.113.10"},
+		{name: "10.113.0.203.IN-ADDR.ARPA", want: "203.0.113.10"},
+		{name: "0.1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", want: "2001:db8::10"},
+		{name: "113.0.203.in-addr.arpa.", want: ""},
+		{name: "1.0.0.2.ip6.arpa.", want: ""},
+		{name: "www.example.com.", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ptrAddress(tt.name); got != tt.want {
+				t.Errorf("ptrAddress(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDNSZonesOfUnknownOwners(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones", jsonHandler(`{"zones": [
+		{"id": "zone-1", "name": "example.com.", "project_id": "project-1"},
+		{"id": "zone-2", "name": "example.org.", "project_id": "project-2"}
+	]}`))
+	mux.HandleFunc("/zones/zone-1/recordsets", jsonHandler(`{"recordsets": [
+		{"id": "rs-1", "name": "www.example.com.", "type": "A", "records": ["203.0.113.10"], "zone_id": "zone-1"}
+	]}`))
+	mux.HandleFunc("/zones/zone-2/recordsets", jsonHandler(`{"recordsets": [
+		{"id": "rs-2", "name": "www.example.org.", "type": "A", "records": ["203.0.113.20"], "zone_id": "zone-2"}
+	]}`))
+	client := newTestClient(t, mux)
+
+	resources, err := client.getDNSZones(map[string]string{"project-1": "web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][2]string{
+		"zone-1": {"project-1", "web"},
+		"rs-1":   {"project-1", "web"},
+		"zone-2": {"project-2", unknownProjectName},
+		"rs-2":   {"project-2", unknownProjectName},
+	}
+	if len(resources) != len(want) {
+		t.Fatalf("got %d resources, want %d", len(resources), len(want))
+	}
+	for _, resource := range resources {
+		if got := [2]string{resource.ProjectID, resource.ProjectName}; got != want[resource.ID] {
+			t.Errorf("%s project = %q, want %q", resource.ID, got, want[resource.ID])
+		}
+	}
+}
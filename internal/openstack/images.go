@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+
+	"openstack-reporter/internal/models"
+)
+
+// getImages lists Glance images owned by the given projects.
+// Public images owned by other projects are left to their owners so they are reported once.
+func (c *Client) getImages(projectNames map[string]string) ([]models.Resource, error) {
+	if c.imageClient == nil {
+		return []models.Resource{}, nil
+	}
+
+	allPages, err := images.List(c.imageClient, images.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	imageList, err := images.ExtractImages(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []models.Resource
+	for _, image := range imageList {
+		projectName, owned := projectNames[image.Owner]
+		if !owned {
+			continue
+		}
+
+		resources = append(resources, models.Resource{
+			ID:          image.ID,
+			Name:        image.Name,
+			Type:        "image",
+			ProjectID:   image.Owner,
+			ProjectName: projectName,
+			Status:      string(image.Status),
+			CreatedAt:   image.CreatedAt,
+			UpdatedAt:   image.UpdatedAt,
+			Properties: models.Image{
+				ID:              image.ID,
+				Name:            image.Name,
+				Status:          string(image.Status),
+				Visibility:      string(image.Visibility),
+				Protected:       image.Protected,
+				SizeBytes:       image.SizeBytes,
+				DiskFormat:      image.DiskFormat,
+				ContainerFormat: image.ContainerFormat,
+				OSType:          imageProperty(image.Properties, "os_type"),
+				OSDistro:        imageProperty(image.Properties, "os_distro"),
+				OSVersion:       imageProperty(image.Properties, "os_version"),
+				Architecture:    imageProperty(image.Properties, "architecture"),
+				OwnerID:         image.Owner,
+				Servers:         []models.ImageServer{},
+				CreatedAt:       image.CreatedAt,
+				UpdatedAt:       image.UpdatedAt,
+			},
+		})
+	}
+
+	return resources, nil
+}
+
+// imageProperty returns an additional image property as a string
+func imageProperty(properties map[string]interface{}, key string) string {
+	value, exists := properties[key]
+	if !exists || value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// serverImageID returns the ID of the image a server was booted from,
+// empty for servers booted from a volume
+func serverImageID(image map[string]interface{}) string {
+	if id, ok := image["id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// linkImageUsage fills the server list of every image from the servers in resources and sets
+// the image name of the servers. Servers of all projects are considered, so public images
+// show their usage across projects.
+func linkImageUsage(resources []models.Resource) {
+	imageIndex := make(map[string]int)
+	for i, resource := range resources {
+		if _, ok := resource.Properties.(models.Image); ok && resource.Type == "image" {
+			imageIndex[resource.ID] = i
+		}
+	}
+	if len(imageIndex) == 0 {
+		return
+	}
+
+	for j, resource := range resources {
+		server, ok := resource.Properties.(models.Server)
+		if !ok || server.ImageID == "" {
+			continue
+		}
+		i, exists := imageIndex[server.ImageID]
+		if !exists {
+			continue
+		}
+		image := resources[i].Properties.(models.Image)
+		server.ImageName = image.Name
+		resources[j].Properties = server
+		image.Servers = append(image.Servers, models.ImageServer{
+			ID:          server.ID,
+			Name:        server.Name,
+			ProjectName: resource.ProjectName,
+		})
+		resources[i].Properties = image
+	}
+}
@@ -0,0 +1,147 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+
+	"openstack-reporter/internal/models"
+)
+
+// loadBalancerTopology holds the listeners and pools of load balancers, keyed by load balancer ID
+type loadBalancerTopology struct {
+	listeners map[string][]models.LoadBalancerListener
+	pools     map[string][]models.LoadBalancerPool
+}
+
+// getLoadBalancerTopology lists listeners, pools, health monitors and pool members visible to
+// the client and groups them per load balancer. Parts that fail to list are left out, so load
+// balancers are still reported with whatever could be collected. Octavia has no listing of the
+// members of all pools, so members take one request per pool that has any.
+func (c *Client) getLoadBalancerTopology() loadBalancerTopology {
+	topology := loadBalancerTopology{
+		listeners: make(map[string][]models.LoadBalancerListener),
+		pools:     make(map[string][]models.LoadBalancerPool),
+	}
+
+	var listenerList []listeners.Listener
+	if allPages, err := listeners.List(c.loadbalancerClient, listeners.ListOpts{}).AllPages(); err == nil {
+		listenerList, _ = listeners.ExtractListeners(allPages)
+	} else {
+		fmt.Printf("DEBUG: Failed to list load balancer listeners: %v\n", err)
+	}
+	for _, listener := range listenerList {
+		item := models.LoadBalancerListener{
+			ID:                 listener.ID,
+			Name:               listener.Name,
+			Protocol:           listener.Protocol,
+			ProtocolPort:       listener.ProtocolPort,
+			DefaultPoolID:      listener.DefaultPoolID,
+			ConnectionLimit:    listener.ConnLimit,
+			AdminStateUp:       listener.AdminStateUp,
+			ProvisioningStatus: listener.ProvisioningStatus,
+			OperatingStatus:    listener.OperatingStatus,
+
+			DefaultTLSContainerRef: listener.DefaultTlsContainerRef,
+			SNIContainerRefs:       listener.SniContainerRefs,
+		}
+		for _, lb := range listener.Loadbalancers {
+			topology.listeners[lb.ID] = append(topology.listeners[lb.ID], item)
+		}
+	}
+
+	monitorsByID := make(map[string]monitors.Monitor)
+	if allPages, err := monitors.List(c.loadbalancerClient, monitors.ListOpts{}).AllPages(); err == nil {
+		monitorList, _ := monitors.ExtractMonitors(allPages)
+		for _, monitor := range monitorList {
+			monitorsByID[monitor.ID] = monitor
+		}
+	} else {
+		fmt.Printf("DEBUG: Failed to list load balancer health monitors: %v\n", err)
+	}
+
+	allPages, err := pools.List(c.loadbalancerClient, pools.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to list load balancer pools: %v\n", err)
+		return topology
+	}
+	poolList, err := pools.ExtractPools(allPages)
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to extract load balancer pools: %v\n", err)
+		return topology
+	}
+
+	for _, pool := range poolList {
+		item := models.LoadBalancerPool{
+			ID:                 pool.ID,
+			Name:               pool.Name,
+			Protocol:           pool.Protocol,
+			LBAlgorithm:        pool.LBMethod,
+			AdminStateUp:       pool.AdminStateUp,
+			ProvisioningStatus: pool.ProvisioningStatus,
+			OperatingStatus:    pool.OperatingStatus,
+			Members:            []models.LoadBalancerMember{},
+		}
+		// The pool listing only carries member IDs, empty pools need no member request
+		if len(pool.Members) > 0 {
+			item.Members = c.getPoolMembers(pool.ID)
+		}
+		for _, listener := range pool.Listeners {
+			item.ListenerIDs = append(item.ListenerIDs, listener.ID)
+		}
+		if monitor, exists := monitorsByID[pool.MonitorID]; exists {
+			item.HealthMonitor = &models.LoadBalancerHealthMonitor{
+				ID:                 monitor.ID,
+				Name:               monitor.Name,
+				Type:               monitor.Type,
+				Delay:              monitor.Delay,
+				Timeout:            monitor.Timeout,
+				MaxRetries:         monitor.MaxRetries,
+				HTTPMethod:         monitor.HTTPMethod,
+				URLPath:            monitor.URLPath,
+				ExpectedCodes:      monitor.ExpectedCodes,
+				AdminStateUp:       monitor.AdminStateUp,
+				ProvisioningStatus: monitor.ProvisioningStatus,
+				OperatingStatus:    monitor.OperatingStatus,
+			}
+		}
+		for _, lb := range pool.Loadbalancers {
+			topology.pools[lb.ID] = append(topology.pools[lb.ID], item)
+		}
+	}
+
+	return topology
+}
+
+// getPoolMembers lists the members of a pool, empty when they can't be listed
+func (c *Client) getPoolMembers(poolID string) []models.LoadBalancerMember {
+	members := []models.LoadBalancerMember{}
+
+	allPages, err := pools.ListMembers(c.loadbalancerClient, poolID, pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to list members of pool %s: %v\n", poolID, err)
+		return members
+	}
+	memberList, err := pools.ExtractMembers(allPages)
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to extract members of pool %s: %v\n", poolID, err)
+		return members
+	}
+
+	for _, member := range memberList {
+		members = append(members, models.LoadBalancerMember{
+			ID:                 member.ID,
+			Name:               member.Name,
+			Address:            member.Address,
+			ProtocolPort:       member.ProtocolPort,
+			Weight:             member.Weight,
+			Backup:             member.Backup,
+			AdminStateUp:       member.AdminStateUp,
+			ProvisioningStatus: member.ProvisioningStatus,
+			OperatingStatus:    member.OperatingStatus,
+		})
+	}
+	return members
+}
@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// networkAttributes are network attributes of the external-net, provider, mtu, port-security and
+// network availability zone extensions. Provider attributes are only returned to admins.
+type networkAttributes struct {
+	ID                  string      `json:"id"`
+	External            bool        `json:"router:external"`
+	NetworkType         string      `json:"provider:network_type"`
+	PhysicalNetwork     string      `json:"provider:physical_network"`
+	SegmentationID      interface{} `json:"provider:segmentation_id"`
+	MTU                 int         `json:"mtu"`
+	PortSecurityEnabled *bool       `json:"port_security_enabled"`
+	AvailabilityZones   []string    `json:"availability_zones"`
+	Segments            []struct {
+		NetworkType     string      `json:"provider:network_type"`
+		PhysicalNetwork string      `json:"provider:physical_network"`
+		SegmentationID  interface{} `json:"provider:segmentation_id"`
+	} `json:"segments"`
+}
+
+// extractNetworkAttributes maps network IDs to their extension attributes. Networks with
+// several segments carry the provider attributes of their first segment.
+func extractNetworkAttributes(allPages pagination.Page) map[string]networkAttributes {
+	var attributes []networkAttributes
+	byID := make(map[string]networkAttributes)
+	if err := networks.ExtractNetworksInto(allPages, &attributes); err != nil {
+		return byID
+	}
+	for _, network := range attributes {
+		if network.NetworkType == "" && len(network.Segments) > 0 {
+			network.NetworkType = network.Segments[0].NetworkType
+			network.PhysicalNetwork = network.Segments[0].PhysicalNetwork
+			network.SegmentationID = network.Segments[0].SegmentationID
+		}
+		byID[network.ID] = network
+	}
+	return byID
+}
+
+// segmentationID returns the segmentation ID, which Neutron returns as a number or a
+// string depending on the plugin, zero when there is none
+func segmentationID(value interface{}) int {
+	switch v := value.(type) {
+	case float64:
+		return int(v)
+	case string:
+		id, _ := strconv.Atoi(v)
+		return id
+	}
+	return 0
+}
@@ -0,0 +1,129 @@
+package openstack
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+
+	"openstack-reporter/internal/models"
+)
+
+// publicReadACL is the Swift read ACL element granting anonymous access
+const publicReadACL = ".r:*"
+
+// getContainers lists the Swift containers of the client's project account with their usage and ACLs,
+// together with an object_account resource holding the stats of the whole account.
+// Swift has no all-tenants listing, every project's account is read through its own token.
+func (c *Client) getContainers(projectNames map[string]string) ([]models.Resource, error) {
+	if c.objectClient == nil {
+		return []models.Resource{}, nil
+	}
+
+	// The account is the one of the token's project, whatever the client was configured with
+	project, err := tokenProject(c.provider)
+	if err != nil {
+		return nil, err
+	}
+	projectName := projectNames[project.ID]
+	if projectName == "" {
+		projectName = project.Name
+	}
+
+	var resources []models.Resource
+
+	// Account stats also count containers the listing doesn't return
+	accountHeader, err := accounts.Get(c.objectClient, nil).Extract()
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to get stats of account %s: %v\n", project.ID, err)
+	} else {
+		accountName := path.Base(strings.TrimSuffix(c.objectClient.Endpoint, "/"))
+		resources = append(resources, models.Resource{
+			ID:          accountName,
+			Name:        accountName,
+			Type:        "object_account",
+			ProjectID:   project.ID,
+			ProjectName: projectName,
+			Properties: models.ObjectAccount{
+				Name:           accountName,
+				ContainerCount: accountHeader.ContainerCount,
+				ObjectCount:    accountHeader.ObjectCount,
+				BytesUsed:      accountHeader.BytesUsed,
+				QuotaBytes:     accountHeader.QuotaBytes,
+			},
+		})
+	}
+
+	allPages, err := containers.List(c.objectClient, containers.ListOpts{Full: true}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	containerList, err := containers.ExtractInfo(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	// ACLs are only returned by a HEAD request on each container
+	properties := make([]models.ObjectContainer, len(containerList))
+	runBounded(len(containerList), getConcurrencyLimit("CONTAINER_CONCURRENCY", defaultContainerConcurrency), func(i int) {
+		container := containerList[i]
+		properties[i] = models.ObjectContainer{
+			Name:        container.Name,
+			ObjectCount: container.Count,
+			BytesUsed:   container.Bytes,
+		}
+
+		header, err := containers.Get(c.objectClient, container.Name, nil).Extract()
+		if err != nil {
+			fmt.Printf("DEBUG: Failed to get ACLs of container %s: %v\n", container.Name, err)
+			return
+		}
+		properties[i].ReadACL = cleanACL(header.Read)
+		properties[i].WriteACL = cleanACL(header.Write)
+		properties[i].StoragePolicy = header.StoragePolicy
+		properties[i].Public = isPublicReadACL(properties[i].ReadACL)
+	})
+
+	for _, container := range properties {
+		status := "private"
+		if container.Public {
+			status = "public"
+		}
+
+		resources = append(resources, models.Resource{
+			ID:          project.ID + "/" + container.Name,
+			Name:        container.Name,
+			Type:        "container",
+			ProjectID:   project.ID,
+			ProjectName: projectName,
+			Status:      status,
+			Properties:  container,
+		})
+	}
+
+	return resources, nil
+}
+
+// cleanACL trims ACL elements and drops the empty element gophercloud returns for a missing header
+func cleanACL(acl []string) []string {
+	var cleaned []string
+	for _, element := range acl {
+		if element = strings.TrimSpace(element); element != "" {
+			cleaned = append(cleaned, element)
+		}
+	}
+	return cleaned
+}
+
+// isPublicReadACL reports whether a read ACL lets anonymous users read objects
+func isPublicReadACL(acl []string) bool {
+	for _, element := range acl {
+		if element == publicReadACL {
+			return true
+		}
+	}
+	return false
+}
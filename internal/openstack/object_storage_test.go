@@ -0,0 +1,135 @@
+package openstack
+
+import (
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+
+	"openstack-reporter/internal/models"
+)
+
+func TestCleanACL(t *testing.T) {
+	tests := []struct {
+		name string
+		acl  []string
+		want []string
+	}{
+		{name: "missing header", acl: []string{""}, want: nil},
+		{name: "nil", acl: nil, want: nil},
+		{name: "single element", acl: []string{".r:*"}, want: []string{".r:*"}},
+		{name: "spaces around elements", acl: []string{" .r:* ", " .rlistings"}, want: []string{".r:*", ".rlistings"}},
+		{name: "empty elements", acl: []string{"project:user", " ", "", "other:*"}, want: []string{"project:user", "other:*"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cleanACL(tt.acl); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cleanACL(%q) = %q, want %q", tt.acl, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPublicReadACL(t *testing.T) {
+	tests := []struct {
+		name string
+		acl  []string
+		want bool
+	}{
+		{name: "empty", acl: nil, want: false},
+		{name: "public", acl: []string{".r:*"}, want: true},
+		{name: "public with listings", acl: []string{".r:*", ".rlistings"}, want: true},
+		{name: "listings only", acl: []string{".rlistings"}, want: false},
+		{name: "referrer domain", acl: []string{".r:example.com"}, want: false},
+		{name: "denied referrer", acl: []string{".r:-*"}, want: false},
+		{name: "project users", acl: []string{"project:*", "other:user"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isPublicReadACL(tt.acl); got != tt.want {
+				t.Errorf("isPublicReadACL(%q) = %v, want %v", tt.acl, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetContainers(t *testing.T) {
+	var heads int64
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/" && r.Method == http.MethodHead:
+			w.Header().Set("X-Account-Container-Count", "3")
+			w.Header().Set("X-Account-Object-Count", "30")
+			w.Header().Set("X-Account-Bytes-Used", "3000")
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/" && r.URL.Query().Get("marker") != "":
+			// The listing is paged by marker until an empty page
+			jsonHandler(`[]`)(w, r)
+		case r.URL.Path == "/":
+			jsonHandler(`[{"name": "public", "count": 10, "bytes": 1000}, {"name": "private", "count": 5, "bytes": 500}]`)(w, r)
+		case r.Method == http.MethodHead:
+			atomic.AddInt64(&heads, 1)
+			if r.URL.Path == "/public" {
+				w.Header().Set("X-Container-Read", ".r:*,.rlistings")
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	// The account is the one of the token's project
+	authResult := tokens.CreateResult{}
+	authResult.Body = map[string]interface{}{"token": map[string]interface{}{
+		"project": map[string]interface{}{"id": "project-1", "name": "web"},
+	}}
+	if err := client.provider.SetTokenAndAuthResult(authResult); err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := client.getContainers(map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if heads != 2 {
+		t.Errorf("sent %d container HEAD requests, want 2", heads)
+	}
+
+	var summary models.Summary
+	var accounts, containers int
+	for _, resource := range resources {
+		if resource.ProjectID != "project-1" || resource.ProjectName != "web" {
+			t.Errorf("%s project = %q, %q, want project-1, web", resource.ID, resource.ProjectID, resource.ProjectName)
+		}
+		switch resource.Type {
+		case "object_account":
+			accounts++
+			summary.AddObjectAccount(resource)
+		case "container":
+			containers++
+			summary.AddContainer(resource)
+			wantStatus := "private"
+			if resource.Name == "public" {
+				wantStatus = "public"
+			}
+			if resource.Status != wantStatus {
+				t.Errorf("container %s status = %q, want %q", resource.Name, resource.Status, wantStatus)
+			}
+		}
+	}
+	if accounts != 1 || containers != 2 {
+		t.Fatalf("got %d accounts and %d containers, want 1 and 2", accounts, containers)
+	}
+
+	want := []models.ProjectObjectStorage{{
+		ProjectID: "project-1", ProjectName: "web",
+		Containers: 2, PublicContainers: 1, Objects: 15, BytesUsed: 1500,
+		AccountContainers: 3, AccountObjects: 30, AccountBytesUsed: 3000,
+	}}
+	if !reflect.DeepEqual(summary.ObjectStorage, want) {
+		t.Errorf("object storage summary = %+v, want %+v", summary.ObjectStorage, want)
+	}
+}
@@ -0,0 +1,168 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+
+	"openstack-reporter/internal/models"
+)
+
+// Reasons for reporting a port as orphaned
+const (
+	orphanDown                = "down"
+	orphanNoDevice            = "no_device"
+	orphanDeletedServer       = "deleted_server"
+	orphanDeletedLoadBalancer = "deleted_load_balancer"
+)
+
+// getPorts lists Neutron ports and marks those that are down, unbound or left behind by a deleted device
+func (c *Client) getPorts(projectNames map[string]string) ([]models.Resource, error) {
+	// Get current project info for fallback
+	currentProject, _ := c.getCurrentProject()
+
+	allPages, err := ports.List(c.networkClient, c.portListOpts()).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	portList, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, err
+	}
+	c.seedPorts(portList)
+
+	networkNames := c.getNetworkNames()
+
+	var resources []models.Resource
+	for _, port := range portList {
+		// Ports of projects outside the collected ones keep their owner instead of being
+		// moved to the current project
+		projectID := firstNonEmpty(port.ProjectID, port.TenantID)
+		projectName := projectNames[projectID]
+		switch {
+		case projectID == "":
+			projectID = currentProject.ID
+			projectName = currentProject.Name
+		case projectName == "":
+			projectName = unknownProjectName
+		}
+
+		fixedIPs := []models.PortFixedIP{}
+		for _, ip := range port.FixedIPs {
+			fixedIPs = append(fixedIPs, models.PortFixedIP{SubnetID: ip.SubnetID, IPAddress: ip.IPAddress})
+		}
+		securityGroups := port.SecurityGroups
+		if securityGroups == nil {
+			securityGroups = []string{}
+		}
+
+		var reasons []string
+		if port.Status == "DOWN" {
+			reasons = append(reasons, orphanDown)
+		}
+		deviceName := ""
+		if port.DeviceID == "" {
+			reasons = append(reasons, orphanNoDevice)
+		} else {
+			var reason string
+			deviceName, reason = c.portDevice(port)
+			if reason != "" {
+				reasons = append(reasons, reason)
+			}
+		}
+
+		resources = append(resources, models.Resource{
+			ID:          port.ID,
+			Name:        port.Name,
+			Type:        "port",
+			ProjectID:   projectID,
+			ProjectName: projectName,
+			Status:      port.Status,
+			CreatedAt:   port.CreatedAt,
+			UpdatedAt:   port.UpdatedAt,
+			Properties: models.Port{
+				ID:             port.ID,
+				Name:           port.Name,
+				Status:         port.Status,
+				AdminStateUp:   port.AdminStateUp,
+				NetworkID:      port.NetworkID,
+				NetworkName:    networkNames[port.NetworkID],
+				MACAddress:     port.MACAddress,
+				DeviceOwner:    port.DeviceOwner,
+				DeviceID:       port.DeviceID,
+				DeviceName:     deviceName,
+				FixedIPs:       fixedIPs,
+				SecurityGroups: securityGroups,
+				OrphanReasons:  reasons,
+				CreatedAt:      port.CreatedAt,
+				UpdatedAt:      port.UpdatedAt,
+			},
+		})
+	}
+
+	return resources, nil
+}
+
+// portListOpts lists the ports of the client's project. Project-scoped listings of admins
+// still return every port, so they are filtered by project.
+func (c *Client) portListOpts() ports.ListOpts {
+	listOpts := ports.ListOpts{}
+	if !c.allTenants() {
+		listOpts.ProjectID = c.tokenProjectID()
+	}
+	return listOpts
+}
+
+// portDevice resolves the server or load balancer a port is bound to from the bulk listings
+// of the client's scope. It returns the device name and an orphan reason when the device is
+// gone. Devices that can't be checked, e.g. routers and DHCP agents or devices whose listing
+// failed, are assumed to exist.
+func (c *Client) portDevice(port ports.Port) (string, string) {
+	switch {
+	case strings.HasPrefix(port.DeviceOwner, "compute:"):
+		listed := c.loadServers()
+		if name, ok := c.cache.getServerName(port.DeviceID); ok {
+			return name, ""
+		}
+		if listed {
+			return "", orphanDeletedServer
+		}
+
+	case port.DeviceOwner == "Octavia" || port.DeviceOwner == "neutron:LOADBALANCERV2":
+		if c.loadbalancerClient == nil {
+			return "", ""
+		}
+		listed := c.loadLoadBalancers()
+		// Octavia prefixes the load balancer ID of VIP ports with "lb-"
+		if name, ok := c.cache.getLoadBalancerName(strings.TrimPrefix(port.DeviceID, "lb-")); ok {
+			return name, ""
+		}
+		if listed {
+			return "", orphanDeletedLoadBalancer
+		}
+	}
+
+	return "", ""
+}
+
+// getNetworkNames maps the IDs of networks visible to the client to their names
+func (c *Client) getNetworkNames() map[string]string {
+	names := make(map[string]string)
+	allPages, err := networks.List(c.networkClient, networks.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to list networks for port names: %v\n", err)
+		return names
+	}
+	networkList, err := networks.ExtractNetworks(allPages)
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to extract networks for port names: %v\n", err)
+		return names
+	}
+	for _, network := range networkList {
+		names[network.ID] = network.Name
+	}
+	return names
+}
@@ -0,0 +1,44 @@
+package openstack
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+)
+
+func TestPortDeviceServers(t *testing.T) {
+	tests := []struct {
+		name       string
+		allTenants int // status of the all-tenants server listing
+		deviceID   string
+		wantName   string
+		wantOrphan string
+	}{
+		{name: "listed server", allTenants: http.StatusOK, deviceID: "server-1", wantName: "web"},
+		{name: "deleted server", allTenants: http.StatusOK, deviceID: "server-2", wantOrphan: orphanDeletedServer},
+		{name: "own server after failed all-tenants listing", allTenants: http.StatusForbidden, deviceID: "server-1", wantName: "web"},
+		{name: "other project's server after failed all-tenants listing", allTenants: http.StatusForbidden, deviceID: "server-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/servers/detail" {
+					http.NotFound(w, r)
+					return
+				}
+				if r.URL.Query().Get("all_tenants") != "" && tt.allTenants != http.StatusOK {
+					w.WriteHeader(tt.allTenants)
+					return
+				}
+				jsonHandler(`{"servers": [{"id": "server-1", "name": "web"}]}`)(w, r)
+			}))
+
+			name, orphan := client.portDevice(ports.Port{DeviceOwner: "compute:nova", DeviceID: tt.deviceID})
+			if name != tt.wantName || orphan != tt.wantOrphan {
+				t.Errorf("portDevice() = %q, %q, want %q, %q", name, orphan, tt.wantName, tt.wantOrphan)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package openstack
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	blockstoragequotas "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	computequotas "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
+	networkquotas "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+
+	"openstack-reporter/internal/models"
+)
+
+// getProjectQuota collects Nova, Cinder and Neutron quota limits and usage of a project.
+// A service whose quota API fails is left out, an error is returned only when all of them fail.
+func (c *Client) getProjectQuota(projectID, projectName string) (models.ProjectQuota, error) {
+	quota := models.ProjectQuota{
+		ProjectID:   projectID,
+		ProjectName: projectName,
+		Region:      c.config.RegionName,
+		Usages:      []models.QuotaUsage{},
+	}
+
+	var failures []string
+
+	if compute, err := computequotas.GetDetail(c.computeClient, projectID).Extract(); err == nil {
+		quota.Usages = append(quota.Usages,
+			computeQuota("instances", compute.Instances),
+			computeQuota("cores", compute.Cores),
+			computeQuota("ram", compute.RAM),
+			computeQuota("key_pairs", compute.KeyPairs),
+			computeQuota("server_groups", compute.ServerGroups),
+		)
+	} else {
+		failures = append(failures, fmt.Sprintf("compute: %v", err))
+	}
+
+	if volume, err := blockstoragequotas.GetUsage(c.blockstorageClient, projectID).Extract(); err == nil {
+		quota.Usages = append(quota.Usages,
+			volumeQuota("volumes", volume.Volumes),
+			volumeQuota("gigabytes", volume.Gigabytes),
+			volumeQuota("snapshots", volume.Snapshots),
+			volumeQuota("backups", volume.Backups),
+			volumeQuota("backup_gigabytes", volume.BackupGigabytes),
+		)
+	} else {
+		failures = append(failures, fmt.Sprintf("volume: %v", err))
+	}
+
+	if network, err := networkquotas.GetDetail(c.networkClient, projectID).Extract(); err == nil {
+		quota.Usages = append(quota.Usages,
+			networkQuota("network", network.Network),
+			networkQuota("subnet", network.Subnet),
+			networkQuota("port", network.Port),
+			networkQuota("router", network.Router),
+			networkQuota("floatingip", network.FloatingIP),
+			networkQuota("security_group", network.SecurityGroup),
+			networkQuota("security_group_rule", network.SecurityGroupRule),
+		)
+	} else {
+		failures = append(failures, fmt.Sprintf("network: %v", err))
+	}
+
+	if len(failures) == 3 {
+		return quota, fmt.Errorf("no quota API available: %s", strings.Join(failures, "; "))
+	}
+	for _, failure := range failures {
+		fmt.Printf("DEBUG: Failed to get %s quota of project %s\n", failure, projectName)
+	}
+
+	return quota, nil
+}
+
+// getQuotas collects the quotas of every project in projectNames, ordered by project name
+func (c *Client) getQuotas(projectNames map[string]string) []models.ProjectQuota {
+	projectIDs := make([]string, 0, len(projectNames))
+	for projectID := range projectNames {
+		projectIDs = append(projectIDs, projectID)
+	}
+	sort.Slice(projectIDs, func(i, j int) bool {
+		return projectNames[projectIDs[i]] < projectNames[projectIDs[j]]
+	})
+
+	var quotas []models.ProjectQuota
+	for _, projectID := range projectIDs {
+		quota, err := c.getProjectQuota(projectID, projectNames[projectID])
+		if err != nil {
+			fmt.Printf("DEBUG: Skipping quotas of project %s: %v\n", projectNames[projectID], err)
+			continue
+		}
+		quotas = append(quotas, quota)
+	}
+	return quotas
+}
+
+func computeQuota(resource string, detail computequotas.QuotaDetail) models.QuotaUsage {
+	return models.QuotaUsage{Service: "compute", Resource: resource, Limit: detail.Limit, InUse: detail.InUse, Reserved: detail.Reserved}
+}
+
+func volumeQuota(resource string, usage blockstoragequotas.QuotaUsage) models.QuotaUsage {
+	return models.QuotaUsage{Service: "volume", Resource: resource, Limit: usage.Limit, InUse: usage.InUse, Reserved: usage.Reserved}
+}
+
+func networkQuota(resource string, detail networkquotas.QuotaDetail) models.QuotaUsage {
+	return models.QuotaUsage{Service: "network", Resource: resource, Limit: detail.Limit, InUse: detail.Used, Reserved: detail.Reserved}
+}
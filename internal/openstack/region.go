@@ -0,0 +1,139 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+
+	"openstack-reporter/internal/models"
+)
+
+// regionsAuto asks for all regions found in the service catalog
+const regionsAuto = "auto"
+
+// regionsFromEnv parses OS_REGIONS, a comma-separated region list or "auto"
+func regionsFromEnv() []string {
+	return splitRegions(os.Getenv("OS_REGIONS"))
+}
+
+// splitRegions splits a comma-separated region list, dropping empty entries
+func splitRegions(value string) []string {
+	var regions []string
+	for _, region := range strings.Split(value, ",") {
+		region = strings.TrimSpace(region)
+		if region != "" {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// collectionRegions returns the regions a refresh should cover: the configured list, where
+// "auto" stands for every region with a compute endpoint, or the single configured region
+func (c *Client) collectionRegions() []string {
+	configured := c.config.Regions
+	if len(configured) == 0 {
+		return []string{c.config.RegionName}
+	}
+
+	var regions []string
+	seen := make(map[string]bool)
+	add := func(region string) {
+		if !seen[region] {
+			seen[region] = true
+			regions = append(regions, region)
+		}
+	}
+	for _, region := range configured {
+		if !strings.EqualFold(region, regionsAuto) {
+			add(region)
+			continue
+		}
+		discovered, err := discoverRegions(c.provider, c.config.endpointOpts().Availability)
+		if err != nil || len(discovered) == 0 {
+			fmt.Printf("DEBUG: Region discovery failed (%v), using region %q\n", err, c.config.RegionName)
+			discovered = []string{c.config.RegionName}
+		}
+		for _, discoveredRegion := range discovered {
+			add(discoveredRegion)
+		}
+	}
+	return regions
+}
+
+// discoverRegions lists the regions that have a compute endpoint in the token's service catalog
+func discoverRegions(provider *gophercloud.ProviderClient, availability gophercloud.Availability) ([]string, error) {
+	result, ok := provider.GetAuthResult().(tokens.CreateResult)
+	if !ok {
+		return nil, fmt.Errorf("no v3 authentication result available")
+	}
+
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract service catalog: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, entry := range catalog.Entries {
+		if entry.Type != "compute" {
+			continue
+		}
+		for _, endpoint := range entry.Endpoints {
+			if endpoint.Interface != string(availability) {
+				continue
+			}
+			region := firstNonEmpty(endpoint.RegionID, endpoint.Region)
+			if region != "" {
+				seen[region] = true
+			}
+		}
+	}
+
+	regions := make([]string, 0, len(seen))
+	for region := range seen {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	return regions, nil
+}
+
+// forRegion returns a client for the same token and scope bound to another region.
+// The client itself is returned when it already targets that region.
+func (c *Client) forRegion(region string) (*Client, error) {
+	if region == c.config.RegionName {
+		return c, nil
+	}
+
+	regionConfig := *c.config
+	regionConfig.RegionName = region
+
+	regionClient, err := newClientFromProvider(c.ctx, c.provider, &regionConfig, c.scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clients for region %s: %w", region, err)
+	}
+	regionClient.cache = c.cache
+	regionClient.runProjects = c.runProjects
+
+	return regionClient, nil
+}
+
+// tagRegion sets the region of every resource
+func tagRegion(resources []models.Resource, region string) []models.Resource {
+	for i := range resources {
+		resources[i].Region = region
+	}
+	return resources
+}
+
+// regionSuffix formats a region for log prefixes
+func regionSuffix(region string) string {
+	if region == "" {
+		return ""
+	}
+	return "@" + region
+}
@@ -0,0 +1,63 @@
+package openstack
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func TestSplitRegions(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "empty", value: "", want: nil},
+		{name: "single", value: "RegionOne", want: []string{"RegionOne"}},
+		{name: "list", value: "RegionOne,RegionTwo", want: []string{"RegionOne", "RegionTwo"}},
+		{name: "spaces", value: " RegionOne , RegionTwo ", want: []string{"RegionOne", "RegionTwo"}},
+		{name: "empty entries", value: ",RegionOne,,RegionTwo,", want: []string{"RegionOne", "RegionTwo"}},
+		{name: "only separators", value: " , ,", want: nil},
+		{name: "auto", value: "auto", want: []string{"auto"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitRegions(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitRegions(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectionRegions(t *testing.T) {
+	tests := []struct {
+		name       string
+		regionName string
+		regions    []string
+		want       []string
+	}{
+		{name: "configured region only", regionName: "RegionOne", want: []string{"RegionOne"}},
+		{name: "no region", regionName: "", want: []string{""}},
+		{name: "configured list", regionName: "RegionOne", regions: []string{"RegionTwo", "RegionThree"}, want: []string{"RegionTwo", "RegionThree"}},
+		{name: "auto falls back to the configured region", regionName: "RegionOne", regions: []string{"auto"}, want: []string{"RegionOne"}},
+		{name: "auto is case-insensitive", regionName: "RegionOne", regions: []string{"AUTO"}, want: []string{"RegionOne"}},
+		{name: "list with auto among others", regionName: "RegionOne", regions: []string{"auto", "RegionTwo"}, want: []string{"RegionOne", "RegionTwo"}},
+		{name: "auto after the configured region", regionName: "RegionOne", regions: []string{"RegionOne", "auto"}, want: []string{"RegionOne"}},
+		{name: "duplicate regions", regionName: "RegionOne", regions: []string{"RegionTwo", "RegionTwo"}, want: []string{"RegionTwo"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The provider has no token, so catalog discovery fails
+			client := &Client{
+				provider: &gophercloud.ProviderClient{},
+				config:   &cloudConfig{RegionName: tt.regionName, Regions: tt.regions},
+			}
+			if got := client.collectionRegions(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("collectionRegions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
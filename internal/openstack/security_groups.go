@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+
+	"openstack-reporter/internal/models"
+)
+
+// getSecurityGroups lists security groups with their rules and the ports and servers using them
+func (c *Client) getSecurityGroups(projectNames map[string]string) ([]models.Resource, error) {
+	// Get current project info for fallback
+	currentProject, _ := c.getCurrentProject()
+
+	// Project-scoped listings of admins still return every group, so they are filtered by project
+	listOpts := groups.ListOpts{}
+	if !c.allTenants() {
+		listOpts.ProjectID = c.tokenProjectID()
+	}
+	allPages, err := groups.List(c.networkClient, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	groupList, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	// Index ports by security group from the port listing of the same scope and region
+	portsByGroup := make(map[string][]models.SecurityGroupPort)
+	for _, port := range c.scopePorts() {
+		var fixedIPs []string
+		for _, ip := range port.FixedIPs {
+			fixedIPs = append(fixedIPs, ip.IPAddress)
+		}
+		for _, groupID := range port.SecurityGroups {
+			portsByGroup[groupID] = append(portsByGroup[groupID], models.SecurityGroupPort{
+				ID:          port.ID,
+				Name:        port.Name,
+				DeviceOwner: port.DeviceOwner,
+				DeviceID:    port.DeviceID,
+				FixedIPs:    fixedIPs,
+			})
+		}
+	}
+
+	if len(portsByGroup) > 0 {
+		c.loadServers()
+	}
+
+	var resources []models.Resource
+	for _, group := range groupList {
+		// Groups of projects outside the collected ones keep their owner instead of being
+		// moved to the current project
+		projectID := firstNonEmpty(group.ProjectID, group.TenantID)
+		projectName := projectNames[projectID]
+		switch {
+		case projectID == "":
+			projectID = currentProject.ID
+			projectName = currentProject.Name
+		case projectName == "":
+			projectName = unknownProjectName
+		}
+
+		var rules []models.SecurityGroupRule
+		for _, rule := range group.Rules {
+			rules = append(rules, models.SecurityGroupRule{
+				ID:             rule.ID,
+				Direction:      rule.Direction,
+				EtherType:      rule.EtherType,
+				Protocol:       rule.Protocol,
+				PortRangeMin:   rule.PortRangeMin,
+				PortRangeMax:   rule.PortRangeMax,
+				RemoteIPPrefix: rule.RemoteIPPrefix,
+				RemoteGroupID:  rule.RemoteGroupID,
+				Description:    rule.Description,
+			})
+		}
+
+		groupPorts := portsByGroup[group.ID]
+		if groupPorts == nil {
+			groupPorts = []models.SecurityGroupPort{}
+		}
+
+		// Servers are the compute devices behind the ports, named from the bulk server listing
+		servers := []models.SecurityGroupServer{}
+		seenServers := make(map[string]bool)
+		for _, port := range groupPorts {
+			if !strings.HasPrefix(port.DeviceOwner, "compute:") || port.DeviceID == "" || seenServers[port.DeviceID] {
+				continue
+			}
+			seenServers[port.DeviceID] = true
+			serverName, _ := c.cache.getServerName(port.DeviceID)
+			servers = append(servers, models.SecurityGroupServer{
+				ID:   port.DeviceID,
+				Name: serverName,
+			})
+		}
+
+		resources = append(resources, models.Resource{
+			ID:          group.ID,
+			Name:        group.Name,
+			Type:        "security_group",
+			ProjectID:   projectID,
+			ProjectName: projectName,
+			CreatedAt:   group.CreatedAt,
+			UpdatedAt:   group.UpdatedAt,
+			Properties: models.SecurityGroup{
+				ID:          group.ID,
+				Name:        group.Name,
+				Description: group.Description,
+				Rules:       rules,
+				Ports:       groupPorts,
+				Servers:     servers,
+				CreatedAt:   group.CreatedAt,
+				UpdatedAt:   group.UpdatedAt,
+			},
+		})
+	}
+
+	return resources, nil
+}
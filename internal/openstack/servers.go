@@ -0,0 +1,132 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/pagination"
+
+	"openstack-reporter/internal/models"
+)
+
+// serverListMicroversion is the compute microversion servers are listed with. It is the first
+// one returning tags, the locked state is returned since 2.9. Flavors stay references to the
+// flavor ID up to 2.46.
+const serverListMicroversion = "2.26"
+
+// powerStates names the power_state values of Nova
+var powerStates = map[int]string{
+	0: "NOSTATE",
+	1: "RUNNING",
+	3: "PAUSED",
+	4: "SHUTDOWN",
+	6: "CRASHED",
+	7: "SUSPENDED",
+}
+
+// serverAttributes are server attributes of extensions that servers.Server doesn't decode
+type serverAttributes struct {
+	ID               string `json:"id"`
+	Host             string `json:"OS-EXT-SRV-ATTR:host"`
+	AvailabilityZone string `json:"OS-EXT-AZ:availability_zone"`
+	PowerState       *int   `json:"OS-EXT-STS:power_state"`
+	Locked           bool   `json:"locked"`
+}
+
+// listServers lists servers with serverListMicroversion, falling back to the default
+// microversion for clouds that don't support it. Other errors are returned as they are.
+func (c *Client) listServers(listOpts servers.ListOpts) (pagination.Page, error) {
+	computeClient := *c.computeClient
+	computeClient.Microversion = serverListMicroversion
+	allPages, err := servers.List(&computeClient, listOpts).AllPages()
+	if microversionUnsupported(err) {
+		fmt.Printf("DEBUG: Listing servers with microversion %s failed, retrying without: %v\n", serverListMicroversion, err)
+		allPages, err = servers.List(c.computeClient, listOpts).AllPages()
+	}
+	return allPages, err
+}
+
+// microversionUnsupported reports whether Nova rejected the requested microversion:
+// 406 Not Acceptable, or 400 Bad Request naming the version as not supported
+func microversionUnsupported(err error) bool {
+	switch e := err.(type) {
+	case gophercloud.ErrUnexpectedResponseCode:
+		// gophercloud has no dedicated error type for 406
+		return e.Actual == http.StatusNotAcceptable
+	case gophercloud.ErrDefault400:
+		body := strings.ToLower(string(e.Body))
+		return strings.Contains(body, "version") && strings.Contains(body, "not supported")
+	}
+	return false
+}
+
+// extractServerAttributes maps server IDs to their extension attributes. The host
+// is only returned to admins.
+func extractServerAttributes(allPages pagination.Page) map[string]serverAttributes {
+	var attributes []serverAttributes
+	byID := make(map[string]serverAttributes)
+	if err := allPages.(servers.ServerPage).ExtractIntoSlicePtr(&attributes, "servers"); err != nil {
+		return byID
+	}
+	for _, server := range attributes {
+		byID[server.ID] = server
+	}
+	return byID
+}
+
+// serverProperties builds the properties of a listed server
+func (c *Client) serverProperties(server servers.Server, attributes serverAttributes) models.Server {
+	flavorName, flavorID := c.getFlavorDetails(server.Flavor)
+	vcpus, ramMB := c.getFlavorSize(flavorID)
+
+	properties := models.Server{
+		ID:               server.ID,
+		Name:             server.Name,
+		Status:           server.Status,
+		FlavorName:       flavorName,
+		FlavorID:         flavorID,
+		ImageID:          serverImageID(server.Image),
+		KeyName:          server.KeyName,
+		AvailabilityZone: attributes.AvailabilityZone,
+		Host:             attributes.Host,
+		VCPUs:            vcpus,
+		RAMMB:            ramMB,
+		Networks:         extractNetworks(server.Addresses),
+		SecurityGroups:   serverSecurityGroups(server.SecurityGroups),
+		Metadata:         server.Metadata,
+		Locked:           attributes.Locked,
+		CreatedAt:        server.Created,
+		UpdatedAt:        server.Updated,
+	}
+	if server.Tags != nil {
+		properties.Tags = *server.Tags
+	}
+	if attributes.PowerState != nil {
+		properties.PowerState = powerStates[*attributes.PowerState]
+	}
+	for _, volume := range server.AttachedVolumes {
+		properties.AttachedVolumes = append(properties.AttachedVolumes, volume.ID)
+	}
+	return properties
+}
+
+// serverSecurityGroups returns the sorted names of a server's security groups. Nova lists
+// a group once per port, so duplicates are dropped.
+func serverSecurityGroups(securityGroups []map[string]interface{}) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, group := range securityGroups {
+		name, _ := group["name"].(string)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,174 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stackresources"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+	"github.com/gophercloud/gophercloud/pagination"
+
+	"openstack-reporter/internal/models"
+)
+
+// stackResourceDepth is how deep resources of nested stacks are listed. Resources of
+// nested stacks are attributed to the top-level stack.
+const stackResourceDepth = 5
+
+// getStacks lists Heat stacks with the resources each of them manages. Nested stacks are
+// listed too, with their parent stack and project (Heat creates them in the project of
+// their parent), but their resources are only listed with their top-level stack.
+func (c *Client) getStacks(projectNames map[string]string) ([]models.Resource, error) {
+	if c.orchestrationClient == nil {
+		return []models.Resource{}, nil
+	}
+
+	// Get current project info for fallback
+	currentProject, _ := c.getCurrentProject()
+
+	listOpts := stacks.ListOpts{AllTenants: c.allTenants(), ShowNested: true}
+	allPages, err := stacks.List(c.orchestrationClient, listOpts).AllPages()
+	if err != nil && listOpts.AllTenants {
+		fmt.Printf("DEBUG: Listing stacks of all projects failed, falling back to own project: %v\n", err)
+		listOpts.AllTenants = false
+		allPages, err = stacks.List(c.orchestrationClient, listOpts).AllPages()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stackList, err := stacks.ExtractStacks(allPages)
+	if err != nil {
+		return nil, err
+	}
+	attributes := stackListAttributes(allPages)
+	stackNames := make(map[string]string)
+	for _, stack := range stackList {
+		stackNames[stack.ID] = stack.Name
+	}
+
+	var resources []models.Resource
+	for _, stack := range stackList {
+		// Get project name, fallback to current project if not found
+		projectID := attributes[stack.ID].project
+		parentID := attributes[stack.ID].parent
+		projectName := projectNames[projectID]
+		if projectName == "" {
+			projectName = currentProject.Name
+			projectID = currentProject.ID
+		}
+
+		// The top-level stack already lists the resources of its nested stacks
+		stackResources := []models.StackResource{}
+		if parentID == "" {
+			stackResources, err = c.getStackResources(stack.Name, stack.ID)
+			if err != nil {
+				fmt.Printf("DEBUG: Failed to list resources of stack %s: %v\n", stack.Name, err)
+			}
+		}
+
+		resources = append(resources, models.Resource{
+			ID:          stack.ID,
+			Name:        stack.Name,
+			Type:        "stack",
+			ProjectID:   projectID,
+			ProjectName: projectName,
+			Status:      stack.Status,
+			CreatedAt:   stack.CreationTime,
+			UpdatedAt:   stack.UpdatedTime,
+			Properties: models.Stack{
+				ID:           stack.ID,
+				Name:         stack.Name,
+				Status:       stack.Status,
+				StatusReason: stack.StatusReason,
+				Description:  stack.Description,
+				Tags:         stack.Tags,
+				ParentID:     parentID,
+				ParentName:   stackNames[parentID],
+				Resources:    stackResources,
+				CreatedAt:    stack.CreationTime,
+				UpdatedAt:    stack.UpdatedTime,
+			},
+		})
+	}
+
+	return resources, nil
+}
+
+// getStackResources lists the resources of a stack including those of its nested stacks
+func (c *Client) getStackResources(stackName, stackID string) ([]models.StackResource, error) {
+	stackResources := []models.StackResource{}
+
+	allPages, err := stackresources.List(c.orchestrationClient, stackName, stackID, stackresources.ListOpts{Depth: stackResourceDepth}).AllPages()
+	if err != nil {
+		return stackResources, err
+	}
+	resourceList, err := stackresources.ExtractResources(allPages)
+	if err != nil {
+		return stackResources, err
+	}
+
+	for _, resource := range resourceList {
+		stackResources = append(stackResources, models.StackResource{
+			Name:       resource.Name,
+			Type:       resource.Type,
+			PhysicalID: resource.PhysicalID,
+			Status:     resource.Status,
+		})
+	}
+	return stackResources, nil
+}
+
+// stackAttributes are the attributes of a listed stack gophercloud doesn't extract
+type stackAttributes struct {
+	project string
+	parent  string
+}
+
+// stackListAttributes maps stack IDs to their project and parent stack. The project is only
+// returned in global listings and the parent only for nested stacks, so both may be empty.
+func stackListAttributes(allPages pagination.Page) map[string]stackAttributes {
+	var listed []struct {
+		ID      string `json:"id"`
+		Project string `json:"project"`
+		Parent  string `json:"parent"`
+	}
+	attributes := make(map[string]stackAttributes)
+	if err := allPages.(stacks.StackPage).ExtractIntoSlicePtr(&listed, "stacks"); err != nil {
+		return attributes
+	}
+	for _, stack := range listed {
+		attributes[stack.ID] = stackAttributes{project: stack.Project, parent: stack.Parent}
+	}
+	return attributes
+}
+
+// linkStackResources sets the stack reference of every resource created by a Heat stack,
+// matching the physical IDs of stack resources with resource IDs. Resources of nested
+// stacks are linked to their top-level stack.
+func linkStackResources(resources []models.Resource) {
+	owners := make(map[string]models.StackReference)
+	for _, resource := range resources {
+		stack, ok := resource.Properties.(models.Stack)
+		if !ok || stack.ParentID != "" {
+			continue
+		}
+		for _, stackResource := range stack.Resources {
+			if stackResource.PhysicalID != "" {
+				owners[stackResource.PhysicalID] = models.StackReference{ID: stack.ID, Name: stack.Name}
+			}
+		}
+	}
+	if len(owners) == 0 {
+		return
+	}
+
+	for i, resource := range resources {
+		if resource.Type == "stack" {
+			continue
+		}
+		if owner, exists := owners[resource.ID]; exists {
+			owner := owner
+			resources[i].Stack = &owner
+		}
+	}
+}
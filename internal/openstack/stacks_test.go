@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+	"github.com/gophercloud/gophercloud/pagination"
+
+	"openstack-reporter/internal/models"
+)
+
+// nestedStackListing is a global stack listing with show_nested, as Heat returns it
+// for a stack "web" with a nested stack "web-servers" inside a nested stack "web-group"
+const nestedStackListing = `{"stacks": [
+	{"id": "stack-1", "stack_name": "web", "stack_status": "CREATE_COMPLETE", "project": "project-1", "parent": null},
+	{"id": "stack-2", "stack_name": "web-group-abc", "stack_status": "CREATE_COMPLETE", "project": "project-1", "parent": "stack-1"},
+	{"id": "stack-3", "stack_name": "web-group-abc-servers-def", "stack_status": "CREATE_COMPLETE", "project": "project-1", "parent": "stack-2"},
+	{"id": "stack-4", "stack_name": "db", "stack_status": "CREATE_COMPLETE", "project": "project-2"}
+]}`
+
+func stackPage(t *testing.T, listing string) pagination.Page {
+	t.Helper()
+	var body interface{}
+	if err := json.Unmarshal([]byte(listing), &body); err != nil {
+		t.Fatal(err)
+	}
+	return stacks.StackPage{SinglePageBase: pagination.SinglePageBase{Result: gophercloud.Result{Body: body}}}
+}
+
+func TestStackListAttributes(t *testing.T) {
+	want := map[string]stackAttributes{
+		"stack-1": {project: "project-1"},
+		"stack-2": {project: "project-1", parent: "stack-1"},
+		"stack-3": {project: "project-1", parent: "stack-2"},
+		"stack-4": {project: "project-2"},
+	}
+	if got := stackListAttributes(stackPage(t, nestedStackListing)); !reflect.DeepEqual(got, want) {
+		t.Errorf("stackListAttributes() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLinkStackResources(t *testing.T) {
+	// Stacks as getStacks builds them from nestedStackListing: the top-level stacks list the
+	// resources of their nested stacks down to stackResourceDepth, nested stacks list none
+	web := models.Resource{ID: "stack-1", Name: "web", Type: "stack", Properties: models.Stack{
+		ID: "stack-1", Name: "web",
+		Resources: []models.StackResource{
+			{Name: "group", Type: "OS::Heat::ResourceGroup", PhysicalID: "stack-2"},
+			{Name: "0", Type: "web-server.yaml", PhysicalID: "stack-3"},
+			{Name: "server", Type: "OS::Nova::Server", PhysicalID: "server-1"},
+			{Name: "port", Type: "OS::Neutron::Port", PhysicalID: "port-1"},
+			{Name: "lb", Type: "OS::Octavia::LoadBalancer", PhysicalID: "lb-1"},
+		},
+	}}
+	group := models.Resource{ID: "stack-2", Name: "web-group-abc", Type: "stack", Properties: models.Stack{
+		ID: "stack-2", Name: "web-group-abc", ParentID: "stack-1", ParentName: "web", Resources: []models.StackResource{},
+	}}
+	servers := models.Resource{ID: "stack-3", Name: "web-group-abc-servers-def", Type: "stack", Properties: models.Stack{
+		ID: "stack-3", Name: "web-group-abc-servers-def", ParentID: "stack-2", ParentName: "web-group-abc", Resources: []models.StackResource{},
+	}}
+	db := models.Resource{ID: "stack-4", Name: "db", Type: "stack", Properties: models.Stack{
+		ID: "stack-4", Name: "db",
+		Resources: []models.StackResource{
+			{Name: "volume", Type: "OS::Cinder::Volume", PhysicalID: "volume-1"},
+			{Name: "pending", Type: "OS::Nova::Server", PhysicalID: ""},
+		},
+	}}
+	resource := func(id, resourceType string) models.Resource {
+		return models.Resource{ID: id, Type: resourceType}
+	}
+
+	tests := []struct {
+		name      string
+		resources []models.Resource
+		want      map[string]string // resource ID -> owning stack ID, resources missing are unlinked
+	}{
+		{
+			name: "resources of nested stacks are linked to the top-level stack",
+			resources: []models.Resource{
+				web, group, servers,
+				resource("server-1", "server"), resource("port-1", "port"), resource("lb-1", "load_balancer"),
+			},
+			want: map[string]string{"server-1": "stack-1", "port-1": "stack-1", "lb-1": "stack-1"},
+		},
+		{
+			name: "nested stacks listed before their top-level stack",
+			resources: []models.Resource{
+				servers, group, resource("server-1", "server"), web,
+			},
+			want: map[string]string{"server-1": "stack-1"},
+		},
+		{
+			name: "resources of several stacks",
+			resources: []models.Resource{
+				web, group, servers, db,
+				resource("server-1", "server"), resource("volume-1", "volume"),
+			},
+			want: map[string]string{"server-1": "stack-1", "volume-1": "stack-4"},
+		},
+		{
+			name:      "resource created outside stacks",
+			resources: []models.Resource{web, group, servers, db, resource("server-2", "server")},
+			want:      map[string]string{},
+		},
+		{
+			name:      "no stacks",
+			resources: []models.Resource{resource("server-1", "server")},
+			want:      map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources := append([]models.Resource(nil), tt.resources...)
+			linkStackResources(resources)
+
+			for _, resource := range resources {
+				want, linked := tt.want[resource.ID]
+				switch {
+				case !linked && resource.Stack != nil:
+					t.Errorf("%s linked to stack %s, want no stack", resource.ID, resource.Stack.ID)
+				case linked && resource.Stack == nil:
+					t.Errorf("%s not linked, want stack %s", resource.ID, want)
+				case linked && resource.Stack.ID != want:
+					t.Errorf("%s linked to stack %s, want %s", resource.ID, resource.Stack.ID, want)
+				}
+			}
+		})
+	}
+}
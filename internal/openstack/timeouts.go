@@ -0,0 +1,101 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultRequestTimeout is how long a single API request may take
+	defaultRequestTimeout = 60 * time.Second
+	// defaultRefreshTimeout is how long a whole refresh may take
+	defaultRefreshTimeout = 30 * time.Minute
+)
+
+// ErrRefreshTimedOut is returned when a refresh exceeds REFRESH_TIMEOUT
+var ErrRefreshTimedOut = errors.New("refresh timed out")
+
+// requestTimeoutKey is the context key of the per-request timeout of a service
+type requestTimeoutKey struct{}
+
+// withServiceTimeout returns ctx carrying the request timeout configured for service
+func withServiceTimeout(ctx context.Context, service string) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, serviceTimeout(service))
+}
+
+// requestTimeout returns the request timeout carried by ctx and whether there is one
+func requestTimeout(ctx context.Context) (time.Duration, bool) {
+	timeout, found := ctx.Value(requestTimeoutKey{}).(time.Duration)
+	return timeout, found
+}
+
+// serviceTimeout returns the request timeout of a catalog service type: its entry in
+// API_TIMEOUTS, a comma-separated list of service=duration, otherwise API_TIMEOUT
+func serviceTimeout(service string) time.Duration {
+	if service == "" {
+		return defaultTimeoutFromEnv()
+	}
+	for _, entry := range strings.Split(os.Getenv("API_TIMEOUTS"), ",") {
+		name, value, found := strings.Cut(entry, "=")
+		if found && strings.TrimSpace(name) == service {
+			return parseTimeout("API_TIMEOUTS", value, defaultTimeoutFromEnv())
+		}
+	}
+	return defaultTimeoutFromEnv()
+}
+
+// defaultTimeoutFromEnv reads API_TIMEOUT, falling back to defaultRequestTimeout
+func defaultTimeoutFromEnv() time.Duration {
+	return parseTimeout("API_TIMEOUT", os.Getenv("API_TIMEOUT"), defaultRequestTimeout)
+}
+
+// RefreshTimeout reads REFRESH_TIMEOUT, the deadline of a whole refresh, falling back to
+// defaultRefreshTimeout. Zero disables the deadline.
+func RefreshTimeout() time.Duration {
+	return parseTimeout("REFRESH_TIMEOUT", os.Getenv("REFRESH_TIMEOUT"), defaultRefreshTimeout)
+}
+
+// parseTimeout parses a duration such as "90s" or "2m", or a number of seconds.
+// Zero disables the timeout, invalid values fall back to defaultValue.
+func parseTimeout(envName, value string, defaultValue time.Duration) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return defaultValue
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		fmt.Printf("DEBUG: Invalid %s value %q, using default %s\n", envName, value, defaultValue)
+		return defaultValue
+	}
+	return timeout
+}
+
+// WithRefreshTimeout returns a copy of parent that expires after RefreshTimeout
+func WithRefreshTimeout(parent context.Context) (context.Context, context.CancelFunc) {
+	timeout := RefreshTimeout()
+	if timeout <= 0 {
+		return context.WithCancel(parent)
+	}
+	return context.WithTimeout(parent, timeout)
+}
+
+// ContextError returns the error of a finished refresh context, ErrRefreshTimedOut when its
+// deadline passed, nil while it is still running
+func ContextError(ctx context.Context) error {
+	switch ctx.Err() {
+	case nil:
+		return nil
+	case context.DeadlineExceeded:
+		return ErrRefreshTimedOut
+	default:
+		return fmt.Errorf("refresh canceled: %w", ctx.Err())
+	}
+}
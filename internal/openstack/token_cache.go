@@ -0,0 +1,215 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+// minTokenRenewMargin is the least time a cached token must stay valid to be reused
+const minTokenRenewMargin = 10 * time.Minute
+
+// cachedToken is a Keystone token together with its service catalog
+type cachedToken struct {
+	result    tokens.CreateResult
+	expiresAt time.Time
+	lifetime  time.Duration // from caching to expiry
+}
+
+// tokenRenewMargin is how long before expiry a cached token stops being reused. Providers
+// don't reauthenticate, so a token must outlive a whole refresh: the margin is the refresh
+// deadline, at least minTokenRenewMargin. It is capped at half the token's lifetime, as
+// tokens shorter lived than the margin could never be reused.
+func tokenRenewMargin(lifetime time.Duration) time.Duration {
+	margin := RefreshTimeout()
+	if margin < minTokenRenewMargin {
+		margin = minTokenRenewMargin
+	}
+	if margin > lifetime/2 {
+		margin = lifetime / 2
+	}
+	return margin
+}
+
+// tokenCache keeps Keystone tokens across refreshes. Credentials are sent once to get an
+// unscoped base token, which is then rescoped to every project and to the user's domain.
+type tokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]cachedToken // keyed by identity and scope
+
+	// baseMu serializes credential authentication so concurrent projects share one base token
+	baseMu sync.Mutex
+}
+
+// sharedTokens is used by all clients of the process
+var sharedTokens = &tokenCache{tokens: make(map[string]cachedToken)}
+
+// get returns a cached token that is valid for at least its renew margin
+func (tc *tokenCache) get(key string) (tokens.CreateResult, bool) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	token, exists := tc.tokens[key]
+	if !exists {
+		return tokens.CreateResult{}, false
+	}
+	if time.Until(token.expiresAt) < tokenRenewMargin(token.lifetime) {
+		delete(tc.tokens, key)
+		return tokens.CreateResult{}, false
+	}
+	return token.result, true
+}
+
+// put stores the token of an authenticated provider
+func (tc *tokenCache) put(key string, provider *gophercloud.ProviderClient) {
+	result, ok := provider.GetAuthResult().(tokens.CreateResult)
+	if !ok {
+		return
+	}
+	token, err := result.ExtractToken()
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to read token expiry, not caching it: %v\n", err)
+		return
+	}
+
+	tc.mu.Lock()
+	tc.tokens[key] = cachedToken{result: result, expiresAt: token.ExpiresAt, lifetime: time.Until(token.ExpiresAt)}
+	tc.mu.Unlock()
+}
+
+// drop removes a token, e.g. after Keystone rejected it
+func (tc *tokenCache) drop(key string) {
+	tc.mu.Lock()
+	delete(tc.tokens, key)
+	tc.mu.Unlock()
+}
+
+// tokenKey identifies the credentials of the cloud and the requested scope
+func (cfg *cloudConfig) tokenKey(scope string) string {
+	identity := firstNonEmpty(cfg.UserID, cfg.UserDomainName+"/"+cfg.Username)
+	if cfg.usesApplicationCredential() {
+		identity = "appcred/" + firstNonEmpty(cfg.ApplicationCredentialID, identity+"/"+cfg.ApplicationCredentialName)
+	}
+	return cfg.AuthURL + "|" + identity + "|" + scope
+}
+
+// providerFromToken creates a provider client that uses a cached token and its service catalog
+func (cfg *cloudConfig) providerFromToken(ctx context.Context, result tokens.CreateResult) (*gophercloud.ProviderClient, error) {
+	provider, err := cfg.newProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract service catalog: %w", err)
+	}
+	if err := provider.SetTokenAndAuthResult(result); err != nil {
+		return nil, fmt.Errorf("failed to use cached token: %w", err)
+	}
+	provider.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+		return openstack.V3EndpointURL(catalog, opts)
+	}
+
+	return provider, nil
+}
+
+// baseToken returns the cached unscoped token, authenticating with the credentials when it is missing or expiring
+func (cfg *cloudConfig) baseToken(ctx context.Context) (string, error) {
+	key := cfg.tokenKey("base")
+
+	sharedTokens.baseMu.Lock()
+	defer sharedTokens.baseMu.Unlock()
+
+	if result, ok := sharedTokens.get(key); ok {
+		return result.ExtractTokenID()
+	}
+
+	opts, err := cfg.baseAuthOptions()
+	if err != nil {
+		return "", err
+	}
+	fmt.Printf("DEBUG: Authenticating with %s\n", cfg.describeAuth())
+
+	provider, err := cfg.authenticate(ctx, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to authenticate: %w", err)
+	}
+	sharedTokens.put(key, provider)
+
+	return provider.Token(), nil
+}
+
+// scopedProvider returns a provider with a token for scope, reusing a cached token when possible
+// and otherwise rescoping the base token. scopeKey names the scope in the cache.
+func (cfg *cloudConfig) scopedProvider(ctx context.Context, scopeKey string, scope *gophercloud.AuthScope) (*gophercloud.ProviderClient, error) {
+	// Application credentials are bound to one project and can't be rescoped
+	if cfg.usesApplicationCredential() {
+		scopeKey = "application_credential"
+		scope = nil
+	}
+	key := cfg.tokenKey(scopeKey)
+
+	if result, ok := sharedTokens.get(key); ok {
+		return cfg.providerFromToken(ctx, result)
+	}
+
+	var provider *gophercloud.ProviderClient
+	if scope == nil {
+		opts, err := cfg.baseAuthOptions()
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("DEBUG: Authenticating with %s\n", cfg.describeAuth())
+		if provider, err = cfg.authenticate(ctx, opts); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if provider, err = cfg.rescope(ctx, scope); err != nil {
+			// The base token may have been revoked, retry once with fresh credentials
+			fmt.Printf("DEBUG: Rescoping to %s failed (%v), re-authenticating\n", scopeKey, err)
+			sharedTokens.drop(cfg.tokenKey("base"))
+			if provider, err = cfg.rescope(ctx, scope); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sharedTokens.put(key, provider)
+	return provider, nil
+}
+
+// rescope exchanges the base token for a token with the given scope
+func (cfg *cloudConfig) rescope(ctx context.Context, scope *gophercloud.AuthScope) (*gophercloud.ProviderClient, error) {
+	baseToken, err := cfg.baseToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg.authenticate(ctx, gophercloud.AuthOptions{
+		IdentityEndpoint: cfg.AuthURL,
+		TokenID:          baseToken,
+		Scope:            scope,
+	})
+}
+
+// projectProvider returns a provider scoped to the given project, by ID when it is known so
+// projects of the same name in different domains and renamed projects get their own tokens
+func (cfg *cloudConfig) projectProvider(ctx context.Context, projectID, projectName string) (*gophercloud.ProviderClient, error) {
+	scopeKey := "project-id/" + projectID
+	if projectID == "" {
+		scopeKey = "project/" + firstNonEmpty(cfg.ProjectDomainName, cfg.ProjectDomainID, cfg.UserDomainName) + "/" + projectName
+	}
+	return cfg.scopedProvider(ctx, scopeKey, cfg.projectScope(projectID, projectName))
+}
+
+// domainProvider returns a provider scoped to the user's domain, used for project listing
+func (cfg *cloudConfig) domainProvider(ctx context.Context) (*gophercloud.ProviderClient, error) {
+	return cfg.scopedProvider(ctx, "domain", cfg.domainScope())
+}
@@ -0,0 +1,297 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultMaxRetries is how often a request failing with 429 or 5xx is retried
+	defaultMaxRetries = 3
+	// retryBaseDelay is the backoff before the first retry, doubled for every further one
+	retryBaseDelay = 500 * time.Millisecond
+	// retryMaxDelay caps the backoff and Retry-After
+	retryMaxDelay = 30 * time.Second
+)
+
+// cloudTransport holds the rate limit, retry count and service endpoints shared by all
+// provider clients of a cloud
+type cloudTransport struct {
+	limiter *rateLimiter
+	retries int64 // accessed atomically
+
+	// endpoints maps the catalog endpoints of the cloud to their service type
+	endpointsMu sync.RWMutex
+	endpoints   map[string]string
+}
+
+// Retries returns the number of retried requests since the process started
+func (t *cloudTransport) Retries() int64 {
+	return atomic.LoadInt64(&t.retries)
+}
+
+// registerEndpoint records the service type of a catalog endpoint, so requests to it get the
+// request timeout of the service
+func (t *cloudTransport) registerEndpoint(endpoint, service string) {
+	if endpoint == "" {
+		return
+	}
+	t.endpointsMu.Lock()
+	defer t.endpointsMu.Unlock()
+	if t.endpoints == nil {
+		t.endpoints = make(map[string]string)
+	}
+	t.endpoints[endpoint] = service
+}
+
+// service returns the service type of the longest registered endpoint url starts with,
+// empty when there is none
+func (t *cloudTransport) service(url string) string {
+	t.endpointsMu.RLock()
+	defer t.endpointsMu.RUnlock()
+
+	var service, longest string
+	for endpoint, endpointService := range t.endpoints {
+		if len(endpoint) > len(longest) && strings.HasPrefix(url, endpoint) {
+			service, longest = endpointService, endpoint
+		}
+	}
+	return service
+}
+
+var (
+	cloudTransportsMu sync.Mutex
+	cloudTransports   = make(map[string]*cloudTransport)
+)
+
+// transport returns the shared transport state of the cloud, created on first use
+func (cfg *cloudConfig) transport() *cloudTransport {
+	key := cfg.Name + "|" + cfg.AuthURL
+
+	cloudTransportsMu.Lock()
+	defer cloudTransportsMu.Unlock()
+
+	if t, exists := cloudTransports[key]; exists {
+		return t
+	}
+	t := &cloudTransport{limiter: newRateLimiter(cfg.RateLimit)}
+	cloudTransports[key] = t
+	return t
+}
+
+// rateLimitFromEnv reads API_RATE_LIMIT, the requests per second allowed per cloud, zero for no limit
+func rateLimitFromEnv() float64 {
+	value := strings.TrimSpace(os.Getenv("API_RATE_LIMIT"))
+	if value == "" {
+		return 0
+	}
+	limit, err := strconv.ParseFloat(value, 64)
+	if err != nil || limit < 0 {
+		fmt.Printf("DEBUG: Invalid API_RATE_LIMIT value %q, not limiting requests\n", value)
+		return 0
+	}
+	return limit
+}
+
+// rateLimiter spaces requests evenly to stay below a requests-per-second limit
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// newRateLimiter returns a limiter for perSecond requests per second, nil for no limit
+func newRateLimiter(perSecond float64) *rateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// wait blocks until the next request may be sent or the request is canceled
+func (l *rateLimiter) wait(req *http.Request) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	return sleep(req, delay)
+}
+
+// retryTransport retries requests answered with 429 or 5xx using exponential backoff with
+// jitter, or the server's Retry-After, and applies the cloud's rate limit to every attempt
+type retryTransport struct {
+	base       http.RoundTripper
+	cloud      *cloudTransport
+	maxRetries int
+}
+
+// newRetryTransport wraps base with the retry and rate limit settings of the cloud
+func (cfg *cloudConfig) newRetryTransport(base http.RoundTripper) *retryTransport {
+	return &retryTransport{
+		base:       base,
+		cloud:      cfg.transport(),
+		maxRetries: getRetryLimit(),
+	}
+}
+
+// getRetryLimit reads API_MAX_RETRIES, falling back to defaultMaxRetries
+func getRetryLimit() int {
+	value := strings.TrimSpace(os.Getenv("API_MAX_RETRIES"))
+	if value == "" {
+		return defaultMaxRetries
+	}
+	retries, err := strconv.Atoi(value)
+	if err != nil || retries < 0 {
+		fmt.Printf("DEBUG: Invalid API_MAX_RETRIES value %q, using default %d\n", value, defaultMaxRetries)
+		return defaultMaxRetries
+	}
+	return retries
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attemptReq := req
+	for attempt := 0; ; attempt++ {
+		if err := t.cloud.limiter.wait(attemptReq); err != nil {
+			return nil, err
+		}
+
+		resp, err := t.roundTripWithTimeout(attemptReq)
+		if err != nil || !retryableStatus(resp.StatusCode) || attempt >= t.maxRetries {
+			return resp, err
+		}
+		// A consumed body can only be sent again when it can be recreated
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		delay := retryDelay(resp, attempt)
+		fmt.Printf("DEBUG: %s %s returned %d, retrying in %s (%d/%d)\n", req.Method, req.URL.Path, resp.StatusCode, delay.Round(time.Millisecond), attempt+1, t.maxRetries)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		atomic.AddInt64(&t.cloud.retries, 1)
+
+		if err := sleep(req, delay); err != nil {
+			return nil, err
+		}
+
+		attemptReq = req.Clone(req.Context())
+		if req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+	}
+}
+
+// roundTripWithTimeout sends a single attempt within its request timeout: the one carried by
+// the request context, otherwise the one of the service the request goes to.
+// The timeout covers reading the body, so it ends when the body is closed.
+func (t *retryTransport) roundTripWithTimeout(req *http.Request) (*http.Response, error) {
+	timeout, found := requestTimeout(req.Context())
+	if !found {
+		timeout = serviceTimeout(t.cloud.service(req.URL.String()))
+	}
+	if timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		if ctx.Err() == context.DeadlineExceeded && req.Context().Err() == nil {
+			return nil, fmt.Errorf("%s %s timed out after %s: %w", req.Method, req.URL.Host, timeout, err)
+		}
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose releases the context of a request once its response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// retryableStatus reports whether a response status is worth retrying
+func retryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || (status >= 500 && status != http.StatusNotImplemented)
+}
+
+// retryDelay returns the server's Retry-After when given, otherwise an exponential backoff
+// with jitter: a random delay between half and all of retryBaseDelay * 2^attempt
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			if seconds >= int(retryMaxDelay/time.Second) {
+				return retryMaxDelay
+			}
+			return time.Duration(seconds) * time.Second
+		}
+		if at, err := http.ParseTime(retryAfter); err == nil {
+			return minDuration(time.Until(at), retryMaxDelay)
+		}
+	}
+
+	// Stop doubling at the cap, shifting further would overflow for large API_MAX_RETRIES
+	backoff := retryBaseDelay
+	for i := 0; i < attempt && backoff < retryMaxDelay; i++ {
+		backoff <<= 1
+	}
+	backoff = minDuration(backoff, retryMaxDelay)
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// minDuration returns the smaller of a and b, never less than zero
+func minDuration(a, b time.Duration) time.Duration {
+	if a < 0 {
+		return 0
+	}
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// sleep waits for delay unless the request is canceled first
+func sleep(req *http.Request, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
@@ -0,0 +1,121 @@
+package openstack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		attempt    int
+		min, max   time.Duration
+	}{
+		{name: "first backoff", attempt: 0, min: retryBaseDelay / 2, max: retryBaseDelay},
+		{name: "second backoff", attempt: 1, min: retryBaseDelay, max: 2 * retryBaseDelay},
+		{name: "third backoff", attempt: 2, min: 2 * retryBaseDelay, max: 4 * retryBaseDelay},
+		{name: "backoff capped", attempt: 10, min: retryMaxDelay / 2, max: retryMaxDelay},
+		{name: "backoff does not overflow", attempt: 100, min: retryMaxDelay / 2, max: retryMaxDelay},
+		{name: "retry after seconds", retryAfter: "3", attempt: 5, min: 3 * time.Second, max: 3 * time.Second},
+		{name: "retry after zero", retryAfter: "0", min: 0, max: 0},
+		{name: "retry after capped", retryAfter: "3600", min: retryMaxDelay, max: retryMaxDelay},
+		{name: "retry after huge", retryAfter: "99999999999999999", min: retryMaxDelay, max: retryMaxDelay},
+		{name: "retry after in the past", retryAfter: "Mon, 02 Jan 2006 15:04:05 GMT", min: 0, max: 0},
+		{name: "retry after far in the future", retryAfter: "Fri, 01 Jan 2100 00:00:00 GMT", min: retryMaxDelay, max: retryMaxDelay},
+		{name: "negative retry after is ignored", retryAfter: "-5", attempt: 0, min: retryBaseDelay / 2, max: retryBaseDelay},
+		{name: "invalid retry after is ignored", retryAfter: "soon", attempt: 1, min: retryBaseDelay, max: 2 * retryBaseDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+			// The backoff is random, a few runs cover its range
+			for i := 0; i < 20; i++ {
+				if got := retryDelay(resp, tt.attempt); got < tt.min || got > tt.max {
+					t.Fatalf("retryDelay(Retry-After %q, attempt %d) = %v, want between %v and %v",
+						tt.retryAfter, tt.attempt, got, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudTransportService(t *testing.T) {
+	transport := &cloudTransport{}
+	transport.registerEndpoint("https://cloud.example.com:8774/v2.1/", "compute")
+	transport.registerEndpoint("https://cloud.example.com/", "identity")
+	transport.registerEndpoint("https://cloud.example.com/swift/v1/AUTH_project/", "object-store")
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://cloud.example.com:8774/v2.1/servers/detail", want: "compute"},
+		{url: "https://cloud.example.com/v3/auth/tokens", want: "identity"},
+		{url: "https://cloud.example.com/swift/v1/AUTH_project/container", want: "object-store"},
+		{url: "https://other.example.com/v3/projects", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := transport.service(tt.url); got != tt.want {
+			t.Errorf("service(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestRequestTimeoutOfService(t *testing.T) {
+	t.Setenv("API_TIMEOUT", "5s")
+	t.Setenv("API_TIMEOUTS", "compute=50ms")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	cfg := &cloudConfig{Name: t.Name(), AuthURL: server.URL}
+	cfg.transport().registerEndpoint(server.URL+"/compute/", "compute")
+	cfg.transport().registerEndpoint(server.URL+"/network/", "network")
+	client := &http.Client{Transport: cfg.newRetryTransport(http.DefaultTransport)}
+
+	tests := []struct {
+		path        string
+		wantTimeout bool
+	}{
+		{path: "/compute/servers", wantTimeout: true},
+		{path: "/network/ports", wantTimeout: false},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+tt.path, nil)
+		resp, err := client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		if timedOut := err != nil; timedOut != tt.wantTimeout {
+			t.Errorf("GET %s error = %v, want timeout %v", tt.path, err, tt.wantTimeout)
+		}
+	}
+}
+
+func TestBindContextSharesProvider(t *testing.T) {
+	client := newTestClient(t, http.NotFoundHandler())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client.bindContext(ctx)
+
+	if client.provider.Context != ctx {
+		t.Errorf("provider context not bound")
+	}
+	for _, service := range []string{"compute", "block-storage", "network", "identity", "load-balancer", "image", "object-store", "dns", "orchestration"} {
+		if client.serviceClient(service).ProviderClient != client.provider {
+			t.Errorf("%s client has its own provider, token updates would not reach it", service)
+		}
+	}
+}
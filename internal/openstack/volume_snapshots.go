@@ -0,0 +1,165 @@
+package openstack
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+
+	"openstack-reporter/internal/models"
+)
+
+// snapshotOwner holds the project attribute that snapshots.Snapshot doesn't expose
+type snapshotOwner struct {
+	ProjectID string `json:"os-extended-snapshot-attributes:project_id"`
+}
+
+// getVolumeSnapshots lists Cinder volume snapshots with their source volumes
+func (c *Client) getVolumeSnapshots(projectNames map[string]string) ([]models.Resource, error) {
+	// Get current project info for fallback
+	currentProject, _ := c.getCurrentProject()
+
+	listOpts := snapshots.ListOpts{AllTenants: c.allTenants()}
+	allPages, err := snapshots.List(c.blockstorageClient, listOpts).AllPages()
+	if err != nil && listOpts.AllTenants {
+		// Fallback to current tenant only if AllTenants fails
+		fmt.Printf("DEBUG: AllTenants failed for volume snapshots, falling back to current project only\n")
+		listOpts.AllTenants = false
+		allPages, err = snapshots.List(c.blockstorageClient, listOpts).AllPages()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotList, err := snapshots.ExtractSnapshots(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var owners []snapshotOwner
+	if err := allPages.(snapshots.SnapshotPage).ExtractIntoSlicePtr(&owners, "snapshots"); err != nil {
+		fmt.Printf("DEBUG: Failed to read snapshot projects: %v\n", err)
+	}
+
+	var resources []models.Resource
+	for i, snapshot := range snapshotList {
+		// The owner is only returned to admins
+		ownerID := ""
+		if i < len(owners) {
+			ownerID = owners[i].ProjectID
+		}
+		projectID, projectName := ownerProject(ownerID, projectNames, listOpts.AllTenants, currentProject)
+
+		volumeName, volumeExists := c.sourceVolume(snapshot.VolumeID)
+
+		resources = append(resources, models.Resource{
+			ID:          snapshot.ID,
+			Name:        snapshot.Name,
+			Type:        "volume_snapshot",
+			ProjectID:   projectID,
+			ProjectName: projectName,
+			Status:      snapshot.Status,
+			CreatedAt:   snapshot.CreatedAt,
+			UpdatedAt:   snapshot.UpdatedAt,
+			Properties: models.VolumeSnapshot{
+				ID:           snapshot.ID,
+				Name:         snapshot.Name,
+				Description:  snapshot.Description,
+				Status:       snapshot.Status,
+				Size:         snapshot.Size,
+				VolumeID:     snapshot.VolumeID,
+				VolumeName:   volumeName,
+				VolumeExists: volumeExists,
+				AgeDays:      ageInDays(snapshot.CreatedAt),
+				CreatedAt:    snapshot.CreatedAt,
+				UpdatedAt:    snapshot.UpdatedAt,
+			},
+		})
+	}
+
+	return resources, nil
+}
+
+// getVolumeBackups lists Cinder volume backups with their source volumes
+func (c *Client) getVolumeBackups(projectNames map[string]string) ([]models.Resource, error) {
+	// Get current project info for fallback
+	currentProject, _ := c.getCurrentProject()
+
+	listOpts := backups.ListDetailOpts{AllTenants: c.allTenants()}
+	allPages, err := backups.ListDetail(c.blockstorageClient, listOpts).AllPages()
+	if err != nil && listOpts.AllTenants {
+		// Fallback to current tenant only if AllTenants fails
+		fmt.Printf("DEBUG: AllTenants failed for volume backups, falling back to current project only\n")
+		listOpts.AllTenants = false
+		allPages, err = backups.ListDetail(c.blockstorageClient, listOpts).AllPages()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	backupList, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []models.Resource
+	for _, backup := range backupList {
+		projectID, projectName := ownerProject(backup.ProjectID, projectNames, listOpts.AllTenants, currentProject)
+
+		volumeName, volumeExists := c.sourceVolume(backup.VolumeID)
+
+		resources = append(resources, models.Resource{
+			ID:          backup.ID,
+			Name:        backup.Name,
+			Type:        "volume_backup",
+			ProjectID:   projectID,
+			ProjectName: projectName,
+			Status:      backup.Status,
+			CreatedAt:   backup.CreatedAt,
+			UpdatedAt:   backup.UpdatedAt,
+			Properties: models.VolumeBackup{
+				ID:           backup.ID,
+				Name:         backup.Name,
+				Description:  backup.Description,
+				Status:       backup.Status,
+				Size:         backup.Size,
+				VolumeID:     backup.VolumeID,
+				VolumeName:   volumeName,
+				VolumeExists: volumeExists,
+				SnapshotID:   backup.SnapshotID,
+				Incremental:  backup.IsIncremental,
+				Container:    backup.Container,
+				ObjectCount:  backup.ObjectCount,
+				FailReason:   backup.FailReason,
+				AgeDays:      ageInDays(backup.CreatedAt),
+				CreatedAt:    backup.CreatedAt,
+				UpdatedAt:    backup.UpdatedAt,
+			},
+		})
+	}
+
+	return resources, nil
+}
+
+// sourceVolume returns the name of a snapshot's or backup's volume and whether it still exists.
+// A volume that can't be checked is reported as existing so it isn't flagged as deleted.
+func (c *Client) sourceVolume(volumeID string) (string, bool) {
+	if volumeID == "" {
+		return "", false
+	}
+	name, exists, err := c.lookupVolume(volumeID)
+	if err != nil {
+		fmt.Printf("DEBUG: Failed to check volume %s: %v\n", volumeID, err)
+		return "", true
+	}
+	return name, exists
+}
+
+// ageInDays returns the number of full days since t
+func ageInDays(t time.Time) int {
+	if t.IsZero() {
+		return 0
+	}
+	return int(time.Since(t).Hours() / 24)
+}
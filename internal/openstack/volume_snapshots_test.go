@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"net/http"
+	"testing"
+
+	"openstack-reporter/internal/models"
+)
+
+func TestOwnerProject(t *testing.T) {
+	projectNames := map[string]string{"project-1": "web"}
+	current := models.Project{ID: "project-current", Name: "current"}
+
+	tests := []struct {
+		name       string
+		projectID  string
+		allTenants bool
+		wantID     string
+		wantName   string
+	}{
+		{name: "collected project", projectID: "project-1", allTenants: true, wantID: "project-1", wantName: "web"},
+		{name: "unknown owner", projectID: "project-2", allTenants: true, wantID: "project-2", wantName: unknownProjectName},
+		{name: "unknown owner of a scoped listing", projectID: "project-2", wantID: "project-2", wantName: unknownProjectName},
+		{name: "no owner in a scoped listing", projectID: "", wantID: "project-current", wantName: "current"},
+		{name: "no owner in an all-tenants listing", projectID: "", allTenants: true, wantID: "", wantName: unknownProjectName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotID, gotName := ownerProject(tt.projectID, projectNames, tt.allTenants, current)
+			if gotID != tt.wantID || gotName != tt.wantName {
+				t.Errorf("ownerProject(%q) = %q, %q, want %q, %q", tt.projectID, gotID, gotName, tt.wantID, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestSnapshotsAndBackupsOfUnknownOwners(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/snapshots", jsonHandler(`{"snapshots": [
+		{"id": "snap-1", "name": "known", "volume_id": "vol-1", "os-extended-snapshot-attributes:project_id": "project-1"},
+		{"id": "snap-2", "name": "foreign", "volume_id": "vol-1", "os-extended-snapshot-attributes:project_id": "project-2"}
+	]}`))
+	mux.HandleFunc("/backups/detail", jsonHandler(`{"backups": [
+		{"id": "backup-1", "name": "known", "volume_id": "vol-1", "os-backup-project-attr:project_id": "project-1"},
+		{"id": "backup-2", "name": "foreign", "volume_id": "vol-1", "os-backup-project-attr:project_id": "project-2"}
+	]}`))
+	mux.HandleFunc("/volumes/detail", jsonHandler(`{"volumes": [{"id": "vol-1", "name": "data"}]}`))
+	client := newTestClient(t, mux)
+	projectNames := map[string]string{"project-1": "web"}
+
+	snapshotList, err := client.getVolumeSnapshots(projectNames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backupList, err := client.getVolumeBackups(projectNames)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][2]string{
+		"snap-1":   {"project-1", "web"},
+		"snap-2":   {"project-2", unknownProjectName},
+		"backup-1": {"project-1", "web"},
+		"backup-2": {"project-2", unknownProjectName},
+	}
+	resources := append(snapshotList, backupList...)
+	if len(resources) != len(want) {
+		t.Fatalf("got %d resources, want %d", len(resources), len(want))
+	}
+	for _, resource := range resources {
+		if got := [2]string{resource.ProjectID, resource.ProjectName}; got != want[resource.ID] {
+			t.Errorf("%s project = %q, want %q", resource.ID, got, want[resource.ID])
+		}
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jung-kurt/gofpdf"
 
+	"openstack-reporter/internal/audit"
 	"openstack-reporter/internal/models"
 )
 
@@ -32,9 +34,44 @@ func (g *Generator) GenerateReport(report *models.ResourceReport) ([]byte, error
 	// Add summary section
 	g.addSummary(pdf, report.Summary)
 
+	// Add per-region breakdown for multi-region reports
+	if len(report.Summary.ByRegion) > 1 {
+		g.addRegionsSection(pdf, report.Summary.ByRegion)
+	}
+
 	// Add projects section
 	g.addProjectsSection(pdf, report.Projects)
 
+	// Add quotas close to their limits
+	g.addQuotasSection(pdf, report.Quotas)
+
+	// Add hypervisor capacity, collected only with admin credentials
+	g.addCapacitySection(pdf, report.Capacity)
+
+	// Add unused private images
+	g.addUnusedImagesSection(pdf, report.Resources)
+
+	// Add security group audit
+	g.addSecurityGroupAuditSection(pdf, report.Resources)
+
+	// Add ports leaking addresses
+	g.addOrphanedPortsSection(pdf, report.Resources)
+
+	// Add load balancers with failing members
+	g.addLoadBalancerHealthSection(pdf, report.Resources)
+
+	// Add snapshots and backups grouped by their volumes
+	g.addVolumeSnapshotsSection(pdf, report.Resources)
+
+	// Add Swift usage per project
+	g.addObjectStorageSection(pdf, report.Summary.ObjectStorage)
+
+	// Add DNS names of floating IPs and records pointing at unallocated addresses
+	g.addDNSSection(pdf, report.Resources)
+
+	// Add users holding admin roles on projects
+	g.addAccessReviewSection(pdf, report.Access)
+
 	// Add detailed resources by project and type
 	g.addDetailedResourcesByProject(pdf, report.Resources)
 
@@ -91,6 +128,15 @@ func (g *Generator) addSummary(pdf *gofpdf.Fpdf, summary models.Summary) {
 		{"VPN Services", strconv.Itoa(summary.TotalVPNServices)},
 		{"Clusters", strconv.Itoa(summary.TotalClusters)},
 		{"Routers", strconv.Itoa(summary.TotalRouters)},
+		{"Images", strconv.Itoa(summary.TotalImages)},
+		{"Security Groups", strconv.Itoa(summary.TotalSecurityGroups)},
+		{"Volume Snapshots", strconv.Itoa(summary.TotalVolumeSnapshots)},
+		{"Volume Backups", strconv.Itoa(summary.TotalVolumeBackups)},
+		{"Ports", strconv.Itoa(summary.TotalPorts)},
+		{"Object Storage Containers", strconv.Itoa(summary.TotalContainers)},
+		{"DNS Zones", strconv.Itoa(summary.TotalDNSZones)},
+		{"DNS Recordsets", strconv.Itoa(summary.TotalDNSRecordsets)},
+		{"Stacks", strconv.Itoa(summary.TotalStacks)},
 	}
 
 	// Create summary table
@@ -104,6 +150,55 @@ func (g *Generator) addSummary(pdf *gofpdf.Fpdf, summary models.Summary) {
 	pdf.Ln(10)
 }
 
+func (g *Generator) addRegionsSection(pdf *gofpdf.Fpdf, byRegion map[string]map[string]int) {
+	// Section title
+	pdf.SetFont("Arial", "B", 14)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Cell(0, 10, "Resources by Region")
+	pdf.Ln(12)
+
+	// Table header
+	pdf.SetFont("Arial", "B", 10)
+	pdf.SetFillColor(200, 200, 200)
+	pdf.CellFormat(40, 8, "Region", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(20, 8, "Total", "1", 0, "R", true, 0, "")
+	pdf.CellFormat(130, 8, "By Type", "1", 1, "L", true, 0, "")
+
+	// Sort regions for a stable layout
+	var regions []string
+	for region := range byRegion {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	pdf.SetFont("Arial", "", 9)
+	for _, region := range regions {
+		counts := byRegion[region]
+
+		var types []string
+		total := 0
+		for resourceType, count := range counts {
+			types = append(types, resourceType)
+			total += count
+		}
+		sort.Strings(types)
+
+		breakdown := ""
+		for i, resourceType := range types {
+			if i > 0 {
+				breakdown += ", "
+			}
+			breakdown += fmt.Sprintf("%s: %d", g.getTypeDisplayName(resourceType), counts[resourceType])
+		}
+
+		pdf.CellFormat(40, 6, g.truncateString(region, 20), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(20, 6, strconv.Itoa(total), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(130, 6, g.truncateString(breakdown, 80), "1", 1, "L", false, 0, "")
+	}
+
+	pdf.Ln(10)
+}
+
 func (g *Generator) addProjectsSection(pdf *gofpdf.Fpdf, projects []models.Project) {
 	// Section title
 	pdf.SetFont("Arial", "B", 14)
@@ -141,7 +236,12 @@ func (g *Generator) addProjectsSection(pdf *gofpdf.Fpdf, projects []models.Proje
 			enabledText = "Yes"
 		}
 
-		pdf.CellFormat(60, 6, g.truncateString(project.Name, 25), "1", 0, "L", false, 0, "")
+		projectName := project.Name
+		if project.Cloud != "" {
+			projectName = project.Cloud + " / " + project.Name
+		}
+
+		pdf.CellFormat(60, 6, g.truncateString(projectName, 25), "1", 0, "L", false, 0, "")
 		pdf.CellFormat(40, 6, g.truncateString(project.ID, 15), "1", 0, "L", false, 0, "")
 		pdf.CellFormat(60, 6, description, "1", 0, "L", false, 0, "")
 		pdf.CellFormat(30, 6, enabledText, "1", 1, "C", false, 0, "")
@@ -150,6 +250,714 @@ func (g *Generator) addProjectsSection(pdf *gofpdf.Fpdf, projects []models.Proje
 	pdf.Ln(10)
 }
 
+func (g *Generator) addQuotasSection(pdf *gofpdf.Fpdf, quotas []models.ProjectQuota) {
+	if len(quotas) == 0 {
+		return
+	}
+	projects := audit.QuotaStatuses(quotas, audit.DefaultQuotaThreshold, true)
+
+	// Section title
+	pdf.SetFont("Arial", "B", 14)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Cell(0, 10, fmt.Sprintf("Quotas Near Limit (%.0f%% and more used)", audit.DefaultQuotaThreshold))
+	pdf.Ln(12)
+
+	if len(projects) == 0 {
+		pdf.SetFont("Arial", "I", 10)
+		pdf.Cell(0, 8, fmt.Sprintf("All quotas of %d projects are below the threshold", len(quotas)))
+		pdf.Ln(15)
+		return
+	}
+
+	// Table header
+	pdf.SetFont("Arial", "B", 9)
+	pdf.SetFillColor(200, 200, 200)
+	pdf.CellFormat(55, 7, "Project", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(30, 7, "Region", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(45, 7, "Quota", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(35, 7, "Used / Limit", "1", 0, "R", true, 0, "")
+	pdf.CellFormat(25, 7, "Used", "1", 1, "R", true, 0, "")
+
+	pdf.SetFont("Arial", "", 8)
+	for _, project := range projects {
+		projectName := project.ProjectName
+		if project.Cloud != "" {
+			projectName = project.Cloud + " / " + project.ProjectName
+		}
+		for _, quota := range project.Quotas {
+			if quota.PercentUsed >= 100 {
+				pdf.SetTextColor(180, 0, 0)
+			}
+			pdf.CellFormat(55, 6, g.truncateString(projectName, 30), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(30, 6, g.truncateString(project.Region, 16), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(45, 6, quota.Service+" / "+quota.Resource, "1", 0, "L", false, 0, "")
+			pdf.CellFormat(35, 6, fmt.Sprintf("%d / %d", quota.InUse, quota.Limit), "1", 0, "R", false, 0, "")
+			pdf.CellFormat(25, 6, fmt.Sprintf("%.0f%%", quota.PercentUsed), "1", 1, "R", false, 0, "")
+			pdf.SetTextColor(0, 0, 0)
+		}
+	}
+
+	pdf.Ln(10)
+}
+
+func (g *Generator) addCapacitySection(pdf *gofpdf.Fpdf, capacity []models.RegionCapacity) {
+	for _, region := range capacity {
+		title := "Compute Capacity"
+		if region.Cloud != "" || region.Region != "" {
+			title += " - " + strings.Trim(region.Cloud+" / "+region.Region, " /")
+		}
+
+		// Section title
+		pdf.SetFont("Arial", "B", 14)
+		pdf.SetTextColor(0, 0, 0)
+		pdf.Cell(0, 10, title)
+		pdf.Ln(12)
+
+		pdf.SetFont("Arial", "", 10)
+		pdf.Cell(0, 6, fmt.Sprintf("%d hypervisors, %d running VMs. vCPU %s, RAM %s, disk %s",
+			len(region.Hypervisors), region.Totals.RunningVMs,
+			usedOfTotal(region.Totals.VCPUsUsed, region.Totals.VCPUs, ""),
+			usedOfTotal(region.Totals.MemoryMBUsed/1024, region.Totals.MemoryMB/1024, " GB"),
+			usedOfTotal(region.Totals.LocalGBUsed, region.Totals.LocalGB, " GB")))
+		pdf.Ln(10)
+
+		// Availability zones
+		if len(region.AvailabilityZones) > 0 {
+			pdf.SetFont("Arial", "B", 9)
+			pdf.SetFillColor(200, 200, 200)
+			pdf.CellFormat(40, 7, "Availability Zone", "1", 0, "L", true, 0, "")
+			pdf.CellFormat(15, 7, "Hosts", "1", 0, "R", true, 0, "")
+			pdf.CellFormat(35, 7, "vCPU", "1", 0, "R", true, 0, "")
+			pdf.CellFormat(40, 7, "RAM", "1", 0, "R", true, 0, "")
+			pdf.CellFormat(40, 7, "Disk", "1", 0, "R", true, 0, "")
+			pdf.CellFormat(20, 7, "VMs", "1", 1, "R", true, 0, "")
+
+			pdf.SetFont("Arial", "", 8)
+			for _, zone := range region.AvailabilityZones {
+				name := zone.Name
+				if !zone.Available {
+					name += " (unavailable)"
+				}
+				pdf.CellFormat(40, 6, g.truncateString(name, 24), "1", 0, "L", false, 0, "")
+				pdf.CellFormat(15, 6, strconv.Itoa(len(zone.Hosts)), "1", 0, "R", false, 0, "")
+				pdf.CellFormat(35, 6, usedOfTotal(zone.Usage.VCPUsUsed, zone.Usage.VCPUs, ""), "1", 0, "R", false, 0, "")
+				pdf.CellFormat(40, 6, usedOfTotal(zone.Usage.MemoryMBUsed/1024, zone.Usage.MemoryMB/1024, " GB"), "1", 0, "R", false, 0, "")
+				pdf.CellFormat(40, 6, usedOfTotal(zone.Usage.LocalGBUsed, zone.Usage.LocalGB, " GB"), "1", 0, "R", false, 0, "")
+				pdf.CellFormat(20, 6, strconv.Itoa(zone.Usage.RunningVMs), "1", 1, "R", false, 0, "")
+			}
+			pdf.Ln(5)
+		}
+
+		// Hypervisors with their largest project
+		pdf.SetFont("Arial", "B", 9)
+		pdf.SetFillColor(200, 200, 200)
+		pdf.CellFormat(40, 7, "Host", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(20, 7, "State", "1", 0, "C", true, 0, "")
+		pdf.CellFormat(25, 7, "vCPU", "1", 0, "R", true, 0, "")
+		pdf.CellFormat(30, 7, "RAM (GB)", "1", 0, "R", true, 0, "")
+		pdf.CellFormat(30, 7, "Disk (GB)", "1", 0, "R", true, 0, "")
+		pdf.CellFormat(12, 7, "VMs", "1", 0, "R", true, 0, "")
+		pdf.CellFormat(33, 7, "Top Project", "1", 1, "L", true, 0, "")
+
+		pdf.SetFont("Arial", "", 8)
+		for _, hypervisor := range region.Hypervisors {
+			state := hypervisor.State
+			if hypervisor.Status != "enabled" {
+				state += "/" + hypervisor.Status
+			}
+			topProject := "-"
+			if len(hypervisor.Projects) > 0 {
+				topProject = fmt.Sprintf("%s (%d)", hypervisor.Projects[0].ProjectName, hypervisor.Projects[0].Servers)
+			}
+			if hypervisor.State != "up" {
+				pdf.SetTextColor(180, 0, 0)
+			}
+			pdf.CellFormat(40, 6, g.truncateString(hypervisor.Host, 24), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(20, 6, state, "1", 0, "C", false, 0, "")
+			pdf.CellFormat(25, 6, usedOfTotal(hypervisor.Usage.VCPUsUsed, hypervisor.Usage.VCPUs, ""), "1", 0, "R", false, 0, "")
+			pdf.CellFormat(30, 6, usedOfTotal(hypervisor.Usage.MemoryMBUsed/1024, hypervisor.Usage.MemoryMB/1024, ""), "1", 0, "R", false, 0, "")
+			pdf.CellFormat(30, 6, usedOfTotal(hypervisor.Usage.LocalGBUsed, hypervisor.Usage.LocalGB, ""), "1", 0, "R", false, 0, "")
+			pdf.CellFormat(12, 6, strconv.Itoa(hypervisor.Usage.RunningVMs), "1", 0, "R", false, 0, "")
+			pdf.CellFormat(33, 6, g.truncateString(topProject, 20), "1", 1, "L", false, 0, "")
+			pdf.SetTextColor(0, 0, 0)
+		}
+
+		pdf.Ln(10)
+	}
+}
+
+// usedOfTotal formats used and total amounts, e.g. "12 / 64 GB"
+func usedOfTotal(used, total int, unit string) string {
+	return fmt.Sprintf("%d / %d%s", used, total, unit)
+}
+
+func (g *Generator) addUnusedImagesSection(pdf *gofpdf.Fpdf, resources []models.Resource) {
+	// Private images that no server was booted from
+	type unusedImage struct {
+		resource models.Resource
+		image    models.Image
+	}
+	var unused []unusedImage
+	for _, resource := range resources {
+		if resource.Type != "image" {
+			continue
+		}
+		var image models.Image
+		if !models.DecodeProperties(resource.Properties, &image) {
+			continue
+		}
+		if image.Visibility == "private" && len(image.Servers) == 0 {
+			unused = append(unused, unusedImage{resource: resource, image: image})
+		}
+	}
+
+	if len(unused) == 0 {
+		return
+	}
+
+	// Largest images first
+	sort.Slice(unused, func(i, j int) bool {
+		return unused[i].image.SizeBytes > unused[j].image.SizeBytes
+	})
+
+	var totalBytes int64
+	for _, item := range unused {
+		totalBytes += item.image.SizeBytes
+	}
+
+	// Section title
+	pdf.SetFont("Arial", "B", 14)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Cell(0, 10, fmt.Sprintf("Unused Private Images (%d, %s)", len(unused), formatBytes(totalBytes)))
+	pdf.Ln(12)
+
+	// Table header
+	pdf.SetFont("Arial", "B", 10)
+	pdf.SetFillColor(200, 200, 200)
+	pdf.CellFormat(70, 8, "Name", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(50, 8, "Project", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(35, 8, "Size", "1", 0, "R", true, 0, "")
+	pdf.CellFormat(35, 8, "Created", "1", 1, "C", true, 0, "")
+
+	pdf.SetFont("Arial", "", 9)
+	for _, item := range unused {
+		name := item.resource.Name
+		if name == "" {
+			name = item.resource.ID
+		}
+		pdf.CellFormat(70, 6, g.truncateString(name, 35), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(50, 6, g.truncateString(item.resource.ProjectName, 25), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(35, 6, formatBytes(item.image.SizeBytes), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(35, 6, item.resource.CreatedAt.Format("2006-01-02"), "1", 1, "C", false, 0, "")
+	}
+
+	pdf.Ln(10)
+}
+
+func (g *Generator) addSecurityGroupAuditSection(pdf *gofpdf.Fpdf, resources []models.Resource) {
+	findings := audit.SecurityGroupFindings(resources)
+
+	// Section title
+	pdf.SetFont("Arial", "B", 14)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Cell(0, 10, "Security Group Audit")
+	pdf.Ln(12)
+
+	if len(findings) == 0 {
+		pdf.SetFont("Arial", "I", 10)
+		pdf.Cell(0, 8, "No rules open sensitive ports to 0.0.0.0/0 or ::/0")
+		pdf.Ln(15)
+		return
+	}
+
+	for _, project := range findings {
+		projectName := project.ProjectName
+		if project.Cloud != "" {
+			projectName = project.Cloud + " / " + project.ProjectName
+		}
+
+		pdf.SetFont("Arial", "B", 11)
+		pdf.SetTextColor(180, 0, 0)
+		pdf.Cell(0, 8, fmt.Sprintf("Project: %s (%d rules)", projectName, len(project.Rules)))
+		pdf.SetTextColor(0, 0, 0)
+		pdf.Ln(9)
+
+		// Table header
+		pdf.SetFont("Arial", "B", 9)
+		pdf.SetFillColor(200, 200, 200)
+		pdf.CellFormat(45, 7, "Security Group", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(30, 7, "Ports", "1", 0, "C", true, 0, "")
+		pdf.CellFormat(25, 7, "Source", "1", 0, "C", true, 0, "")
+		pdf.CellFormat(45, 7, "Services", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(45, 7, "Servers", "1", 1, "L", true, 0, "")
+
+		pdf.SetFont("Arial", "", 8)
+		for _, rule := range project.Rules {
+			ports := "all"
+			if rule.PortRangeMin != 0 || rule.PortRangeMax != 0 {
+				ports = strconv.Itoa(rule.PortRangeMin)
+				if rule.PortRangeMax != rule.PortRangeMin {
+					ports += "-" + strconv.Itoa(rule.PortRangeMax)
+				}
+			}
+
+			servers := "-"
+			if len(rule.Servers) > 0 {
+				servers = strings.Join(rule.Servers, ", ")
+			}
+
+			pdf.CellFormat(45, 6, g.truncateString(rule.SecurityGroupName, 24), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(30, 6, rule.Protocol+" "+ports, "1", 0, "C", false, 0, "")
+			pdf.CellFormat(25, 6, rule.RemoteIPPrefix, "1", 0, "C", false, 0, "")
+			pdf.CellFormat(45, 6, g.truncateString(strings.Join(rule.Services, ", "), 28), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(45, 6, g.truncateString(servers, 28), "1", 1, "L", false, 0, "")
+		}
+		pdf.Ln(5)
+	}
+
+	pdf.Ln(5)
+}
+
+func (g *Generator) addOrphanedPortsSection(pdf *gofpdf.Fpdf, resources []models.Resource) {
+	findings := audit.OrphanedPorts(resources)
+	if len(findings) == 0 {
+		return
+	}
+
+	totalPorts := 0
+	for _, project := range findings {
+		totalPorts += len(project.Ports)
+	}
+
+	// Section title
+	pdf.SetFont("Arial", "B", 14)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Cell(0, 10, fmt.Sprintf("Orphaned Ports (%d)", totalPorts))
+	pdf.Ln(12)
+
+	for _, project := range findings {
+		projectName := project.ProjectName
+		if project.Cloud != "" {
+			projectName = project.Cloud + " / " + project.ProjectName
+		}
+
+		pdf.SetFont("Arial", "B", 11)
+		pdf.Cell(0, 8, fmt.Sprintf("Project: %s (%d ports)", projectName, len(project.Ports)))
+		pdf.Ln(9)
+
+		// Table header
+		pdf.SetFont("Arial", "B", 9)
+		pdf.SetFillColor(200, 200, 200)
+		pdf.CellFormat(35, 7, "Network", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(35, 7, "Fixed IPs", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(40, 7, "Device Owner", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(30, 7, "Device", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(50, 7, "Reasons", "1", 1, "L", true, 0, "")
+
+		pdf.SetFont("Arial", "", 8)
+		for _, port := range project.Ports {
+			network := port.NetworkName
+			if network == "" {
+				network = port.NetworkID
+			}
+			device := port.DeviceID
+			if device == "" {
+				device = "-"
+			}
+
+			pdf.CellFormat(35, 6, g.truncateString(network, 20), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(35, 6, g.truncateString(strings.Join(port.FixedIPs, ", "), 20), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(40, 6, g.truncateString(port.DeviceOwner, 24), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(30, 6, g.truncateString(device, 17), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(50, 6, g.truncateString(strings.Join(port.Reasons, ", "), 30), "1", 1, "L", false, 0, "")
+		}
+		pdf.Ln(5)
+	}
+
+	pdf.Ln(5)
+}
+
+func (g *Generator) addLoadBalancerHealthSection(pdf *gofpdf.Fpdf, resources []models.Resource) {
+	statuses := audit.LoadBalancerHealthStatuses(resources, true)
+	if len(statuses) == 0 {
+		return
+	}
+
+	// Section title
+	pdf.SetFont("Arial", "B", 14)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Cell(0, 10, fmt.Sprintf("Load Balancer Health (%d unhealthy)", len(statuses)))
+	pdf.Ln(12)
+
+	for _, lb := range statuses {
+		projectName := lb.ProjectName
+		if lb.Cloud != "" {
+			projectName = lb.Cloud + " / " + lb.ProjectName
+		}
+
+		pdf.SetFont("Arial", "B", 11)
+		pdf.Cell(0, 8, fmt.Sprintf("%s (%s) - %s", lb.Name, lb.VipAddress, projectName))
+		pdf.Ln(8)
+		pdf.SetFont("Arial", "", 9)
+		pdf.Cell(0, 6, fmt.Sprintf("Status: %s / %s, listeners: %d, pools: %d, members: %d, unhealthy: %d",
+			lb.ProvisioningStatus, lb.OperatingStatus, lb.Listeners, lb.Pools, lb.Members, len(lb.UnhealthyMembers)))
+		pdf.Ln(6)
+		for _, listener := range lb.TLSListeners {
+			certificates := listener.DefaultTLSContainerRef
+			if len(listener.SNIContainerRefs) > 0 {
+				certificates += ", SNI: " + strings.Join(listener.SNIContainerRefs, ", ")
+			}
+			name := listener.Name
+			if name == "" {
+				name = listener.ID
+			}
+			pdf.Cell(0, 6, g.truncateString(fmt.Sprintf("TLS listener %s (:%d): %s", name, listener.ProtocolPort, certificates), 150))
+			pdf.Ln(6)
+		}
+		pdf.Ln(2)
+
+		if len(lb.UnhealthyMembers) == 0 {
+			continue
+		}
+
+		// Table header
+		pdf.SetFont("Arial", "B", 9)
+		pdf.SetFillColor(200, 200, 200)
+		pdf.CellFormat(45, 7, "Pool", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(45, 7, "Member", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(45, 7, "Address", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(27, 7, "Operating", "1", 0, "C", true, 0, "")
+		pdf.CellFormat(28, 7, "Provisioning", "1", 1, "C", true, 0, "")
+
+		pdf.SetFont("Arial", "", 8)
+		for _, member := range lb.UnhealthyMembers {
+			pool := member.PoolName
+			if pool == "" {
+				pool = member.PoolID
+			}
+			pdf.CellFormat(45, 6, g.truncateString(pool, 26), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(45, 6, g.truncateString(member.Name, 26), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(45, 6, fmt.Sprintf("%s:%d", member.Address, member.ProtocolPort), "1", 0, "L", false, 0, "")
+			pdf.SetTextColor(200, 0, 0)
+			pdf.CellFormat(27, 6, member.OperatingStatus, "1", 0, "C", false, 0, "")
+			pdf.SetTextColor(0, 0, 0)
+			pdf.CellFormat(28, 6, member.ProvisioningStatus, "1", 1, "C", false, 0, "")
+		}
+		pdf.Ln(5)
+	}
+
+	pdf.Ln(5)
+}
+
+func (g *Generator) addVolumeSnapshotsSection(pdf *gofpdf.Fpdf, resources []models.Resource) {
+	// Snapshots and backups of one source volume
+	type volumeCopy struct {
+		kind    string
+		name    string
+		project string
+		size    int
+		status  string
+		ageDays int
+	}
+	type volumeGroup struct {
+		name    string
+		exists  bool
+		copies  []volumeCopy
+		totalGB int
+	}
+
+	groups := make(map[string]*volumeGroup)
+	var keys []string
+	addCopy := func(resource models.Resource, volumeID, volumeName string, volumeExists bool, item volumeCopy) {
+		key := resource.Cloud + "|" + resource.Region + "|" + volumeID
+		group, exists := groups[key]
+		if !exists {
+			name := volumeName
+			if name == "" {
+				name = volumeID
+			}
+			group = &volumeGroup{name: name, exists: volumeExists}
+			groups[key] = group
+			keys = append(keys, key)
+		}
+		if item.name == "" {
+			item.name = resource.ID
+		}
+		item.project = resource.ProjectName
+		group.copies = append(group.copies, item)
+		group.totalGB += item.size
+	}
+
+	for _, resource := range resources {
+		switch resource.Type {
+		case "volume_snapshot":
+			var snapshot models.VolumeSnapshot
+			if models.DecodeProperties(resource.Properties, &snapshot) {
+				addCopy(resource, snapshot.VolumeID, snapshot.VolumeName, snapshot.VolumeExists, volumeCopy{
+					kind: "Snapshot", name: snapshot.Name, size: snapshot.Size, status: snapshot.Status, ageDays: snapshot.AgeDays,
+				})
+			}
+		case "volume_backup":
+			var backup models.VolumeBackup
+			if models.DecodeProperties(resource.Properties, &backup) {
+				kind := "Backup"
+				if backup.Incremental {
+					kind = "Backup (incr.)"
+				}
+				addCopy(resource, backup.VolumeID, backup.VolumeName, backup.VolumeExists, volumeCopy{
+					kind: kind, name: backup.Name, size: backup.Size, status: backup.Status, ageDays: backup.AgeDays,
+				})
+			}
+		}
+	}
+
+	if len(keys) == 0 {
+		return
+	}
+
+	// Copies of deleted volumes first, then by volume name
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := groups[keys[i]], groups[keys[j]]
+		if a.exists != b.exists {
+			return !a.exists
+		}
+		return a.name < b.name
+	})
+
+	// Section title
+	pdf.SetFont("Arial", "B", 14)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Cell(0, 10, "Volume Snapshots and Backups")
+	pdf.Ln(12)
+
+	for _, key := range keys {
+		group := groups[key]
+
+		pdf.SetFont("Arial", "B", 11)
+		title := fmt.Sprintf("Volume: %s (%d copies, %d GB)", group.name, len(group.copies), group.totalGB)
+		if !group.exists {
+			title += " - volume deleted"
+			pdf.SetTextColor(180, 0, 0)
+		}
+		pdf.Cell(0, 8, title)
+		pdf.SetTextColor(0, 0, 0)
+		pdf.Ln(9)
+
+		// Table header
+		pdf.SetFont("Arial", "B", 9)
+		pdf.SetFillColor(200, 200, 200)
+		pdf.CellFormat(30, 7, "Type", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(55, 7, "Name", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(40, 7, "Project", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(20, 7, "Size", "1", 0, "R", true, 0, "")
+		pdf.CellFormat(25, 7, "Status", "1", 0, "C", true, 0, "")
+		pdf.CellFormat(20, 7, "Age", "1", 1, "R", true, 0, "")
+
+		pdf.SetFont("Arial", "", 8)
+		for _, item := range group.copies {
+			pdf.CellFormat(30, 6, item.kind, "1", 0, "L", false, 0, "")
+			pdf.CellFormat(55, 6, g.truncateString(item.name, 30), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(40, 6, g.truncateString(item.project, 22), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(20, 6, fmt.Sprintf("%d GB", item.size), "1", 0, "R", false, 0, "")
+			pdf.CellFormat(25, 6, item.status, "1", 0, "C", false, 0, "")
+			pdf.CellFormat(20, 6, fmt.Sprintf("%d d", item.ageDays), "1", 1, "R", false, 0, "")
+		}
+		pdf.Ln(5)
+	}
+
+	pdf.Ln(5)
+}
+
+func (g *Generator) addObjectStorageSection(pdf *gofpdf.Fpdf, usage []models.ProjectObjectStorage) {
+	if len(usage) == 0 {
+		return
+	}
+
+	// Largest accounts first
+	projects := append([]models.ProjectObjectStorage(nil), usage...)
+	sort.Slice(projects, func(i, j int) bool {
+		if projects[i].BytesUsed != projects[j].BytesUsed {
+			return projects[i].BytesUsed > projects[j].BytesUsed
+		}
+		return projects[i].ProjectName < projects[j].ProjectName
+	})
+
+	// Section title
+	pdf.SetFont("Arial", "B", 14)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Cell(0, 10, "Object Storage")
+	pdf.Ln(12)
+
+	// Table header
+	pdf.SetFont("Arial", "B", 9)
+	pdf.SetFillColor(200, 200, 200)
+	pdf.CellFormat(60, 7, "Project", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(22, 7, "Containers", "1", 0, "R", true, 0, "")
+	pdf.CellFormat(18, 7, "Public", "1", 0, "R", true, 0, "")
+	pdf.CellFormat(26, 7, "Objects", "1", 0, "R", true, 0, "")
+	pdf.CellFormat(27, 7, "Size", "1", 0, "R", true, 0, "")
+	pdf.CellFormat(27, 7, "Account size", "1", 1, "R", true, 0, "")
+
+	pdf.SetFont("Arial", "", 8)
+	for _, project := range projects {
+		projectName := project.ProjectName
+		if project.Cloud != "" {
+			projectName = project.Cloud + " / " + project.ProjectName
+		}
+
+		// Account stats are missing when the account couldn't be read
+		accountSize := "-"
+		if project.AccountContainers > 0 || project.AccountBytesUsed > 0 {
+			accountSize = formatBytes(project.AccountBytesUsed)
+		}
+
+		pdf.CellFormat(60, 6, g.truncateString(projectName, 34), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(22, 6, strconv.Itoa(project.Containers), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(18, 6, strconv.Itoa(project.PublicContainers), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(26, 6, strconv.FormatInt(project.Objects, 10), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(27, 6, formatBytes(project.BytesUsed), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(27, 6, accountSize, "1", 1, "R", false, 0, "")
+	}
+
+	pdf.Ln(10)
+}
+
+func (g *Generator) addDNSSection(pdf *gofpdf.Fpdf, resources []models.Resource) {
+	type floatingIPName struct {
+		project    string
+		floatingIP models.FloatingIP
+	}
+	var named []floatingIPName
+	for _, resource := range resources {
+		if resource.Type != "floating_ip" {
+			continue
+		}
+		var floatingIP models.FloatingIP
+		if !models.DecodeProperties(resource.Properties, &floatingIP) || len(floatingIP.DNSNames) == 0 {
+			continue
+		}
+		projectName := resource.ProjectName
+		if resource.Cloud != "" {
+			projectName = resource.Cloud + " / " + resource.ProjectName
+		}
+		named = append(named, floatingIPName{project: projectName, floatingIP: floatingIP})
+	}
+	dangling := audit.DanglingDNSRecords(resources)
+	if len(named) == 0 && len(dangling) == 0 {
+		return
+	}
+
+	// Section title
+	pdf.SetFont("Arial", "B", 14)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Cell(0, 10, "DNS")
+	pdf.Ln(12)
+
+	if len(named) > 0 {
+		sort.Slice(named, func(i, j int) bool {
+			if named[i].project != named[j].project {
+				return named[i].project < named[j].project
+			}
+			return named[i].floatingIP.FloatingIP < named[j].floatingIP.FloatingIP
+		})
+
+		pdf.SetFont("Arial", "B", 11)
+		pdf.Cell(0, 8, fmt.Sprintf("Floating IP Names (%d)", len(named)))
+		pdf.Ln(9)
+
+		// Table header
+		pdf.SetFont("Arial", "B", 9)
+		pdf.SetFillColor(200, 200, 200)
+		pdf.CellFormat(50, 7, "Project", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(35, 7, "Floating IP", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(105, 7, "DNS Names", "1", 1, "L", true, 0, "")
+
+		pdf.SetFont("Arial", "", 8)
+		for _, item := range named {
+			pdf.CellFormat(50, 6, g.truncateString(item.project, 28), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(35, 6, item.floatingIP.FloatingIP, "1", 0, "L", false, 0, "")
+			pdf.CellFormat(105, 6, g.truncateString(strings.Join(item.floatingIP.DNSNames, ", "), 65), "1", 1, "L", false, 0, "")
+		}
+		pdf.Ln(5)
+	}
+
+	for _, project := range dangling {
+		projectName := project.ProjectName
+		if project.Cloud != "" {
+			projectName = project.Cloud + " / " + project.ProjectName
+		}
+
+		pdf.SetFont("Arial", "B", 11)
+		pdf.Cell(0, 8, fmt.Sprintf("Records Pointing at Unallocated Addresses: %s (%d)", projectName, len(project.Records)))
+		pdf.Ln(9)
+
+		// Table header
+		pdf.SetFont("Arial", "B", 9)
+		pdf.SetFillColor(200, 200, 200)
+		pdf.CellFormat(70, 7, "Name", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(15, 7, "Type", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(55, 7, "Zone", "1", 0, "L", true, 0, "")
+		pdf.CellFormat(50, 7, "Unallocated", "1", 1, "L", true, 0, "")
+
+		pdf.SetFont("Arial", "", 8)
+		for _, record := range project.Records {
+			pdf.CellFormat(70, 6, g.truncateString(record.Name, 42), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(15, 6, record.Type, "1", 0, "L", false, 0, "")
+			pdf.CellFormat(55, 6, g.truncateString(record.ZoneName, 33), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(50, 6, g.truncateString(strings.Join(record.Addresses, ", "), 30), "1", 1, "L", false, 0, "")
+		}
+		pdf.Ln(5)
+	}
+
+	pdf.Ln(5)
+}
+
+func (g *Generator) addAccessReviewSection(pdf *gofpdf.Fpdf, access []models.ProjectAccess) {
+	findings := audit.AdminRoleHolders(access)
+	if len(findings) == 0 {
+		return
+	}
+
+	// Section title
+	pdf.SetFont("Arial", "B", 14)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Cell(0, 10, "Access Review: Admin Role Holders")
+	pdf.Ln(12)
+
+	// Table header
+	pdf.SetFont("Arial", "B", 9)
+	pdf.SetFillColor(200, 200, 200)
+	pdf.CellFormat(50, 7, "Project", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(45, 7, "User", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(30, 7, "Domain", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(45, 7, "Via", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(20, 7, "Enabled", "1", 1, "C", true, 0, "")
+
+	pdf.SetFont("Arial", "", 8)
+	for _, project := range findings {
+		projectName := project.ProjectName
+		if project.Cloud != "" {
+			projectName = project.Cloud + " / " + project.ProjectName
+		}
+		for _, admin := range project.Admins {
+			enabled := "-"
+			if admin.Enabled != nil {
+				enabled = "yes"
+				if !*admin.Enabled {
+					enabled = "no"
+				}
+			}
+			pdf.CellFormat(50, 6, g.truncateString(projectName, 30), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(45, 6, g.truncateString(admin.Name, 26), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(30, 6, g.truncateString(admin.Domain, 18), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(45, 6, g.truncateString(admin.Via, 26), "1", 0, "L", false, 0, "")
+			pdf.CellFormat(20, 6, enabled, "1", 1, "C", false, 0, "")
+		}
+	}
+
+	pdf.Ln(10)
+}
+
 func (g *Generator) addDetailedResourcesByProject(pdf *gofpdf.Fpdf, resources []models.Resource) {
 	// Add new page for detailed resources
 	pdf.AddPage()
@@ -166,10 +974,14 @@ func (g *Generator) addDetailedResourcesByProject(pdf *gofpdf.Fpdf, resources []
 		return
 	}
 
-	// Group resources by project
+	// Group resources by project, keeping equally named projects of different clouds apart
 	projectGroups := make(map[string][]models.Resource)
 	for _, resource := range resources {
-		projectGroups[resource.ProjectName] = append(projectGroups[resource.ProjectName], resource)
+		projectKey := resource.ProjectName
+		if resource.Cloud != "" {
+			projectKey = resource.Cloud + " / " + resource.ProjectName
+		}
+		projectGroups[projectKey] = append(projectGroups[projectKey], resource)
 	}
 
 	// Sort projects alphabetically
@@ -270,6 +1082,13 @@ func (g *Generator) addDetailedResourcesByProject(pdf *gofpdf.Fpdf, resources []
 					} else {
 						fmt.Printf("DEBUG: Failed to cast properties to map for %s\n", name)
 					}
+
+					var network models.Network
+					if models.DecodeProperties(resource.Properties, &network) {
+						if attributes := networkAttributes(network); attributes != "" {
+							displayName = fmt.Sprintf("%s\n%s", displayName, attributes)
+						}
+					}
 				}
 
 				// Увеличиваем высоту ячейки для сетей с подсетями
@@ -293,16 +1112,54 @@ func (g *Generator) addDetailedResourcesByProject(pdf *gofpdf.Fpdf, resources []
 	}
 }
 
+// networkAttributes summarizes the external flag, provider segment, MTU and port security of a network
+func networkAttributes(network models.Network) string {
+	var attributes []string
+	if network.External {
+		attributes = append(attributes, "external")
+	}
+	if network.Shared {
+		attributes = append(attributes, "shared")
+	}
+	if network.NetworkType != "" {
+		segment := network.NetworkType
+		if network.PhysicalNetwork != "" {
+			segment += " " + network.PhysicalNetwork
+		}
+		if network.SegmentationID != 0 {
+			segment += fmt.Sprintf(" #%d", network.SegmentationID)
+		}
+		attributes = append(attributes, segment)
+	}
+	if network.MTU != 0 {
+		attributes = append(attributes, fmt.Sprintf("MTU %d", network.MTU))
+	}
+	if network.PortSecurityEnabled != nil && !*network.PortSecurityEnabled {
+		attributes = append(attributes, "no port security")
+	}
+	return strings.Join(attributes, ", ")
+}
+
 func (g *Generator) getTypeDisplayName(resourceType string) string {
 	types := map[string]string{
-		"server":         "Virtual Machine",
-		"volume":         "Volume",
-		"floating_ip":    "Floating IP",
-		"router":         "Router",
-		"network":        "Network",
-		"load_balancer":  "Load Balancer",
-		"vpn_service":    "VPN Service",
-		"cluster":        "K8s Cluster",
+		"server":          "Virtual Machine",
+		"volume":          "Volume",
+		"floating_ip":     "Floating IP",
+		"router":          "Router",
+		"network":         "Network",
+		"load_balancer":   "Load Balancer",
+		"vpn_service":     "VPN Service",
+		"cluster":         "K8s Cluster",
+		"image":           "Image",
+		"security_group":  "Security Group",
+		"volume_snapshot": "Volume Snapshot",
+		"volume_backup":   "Volume Backup",
+		"port":            "Port",
+		"container":       "Object Storage Container",
+		"object_account":  "Object Storage Account",
+		"dns_zone":        "DNS Zone",
+		"dns_recordset":   "DNS Recordset",
+		"stack":           "Heat Stack",
 	}
 
 	if displayName, exists := types[resourceType]; exists {
@@ -319,3 +1176,17 @@ func (g *Generator) truncateString(str string, maxLen int) string {
 	}
 	return str[:maxLen-3] + "..."
 }
+
+// formatBytes formats a byte count with binary units
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/joho/godotenv"
 
 	"openstack-reporter/internal/handlers"
+	"openstack-reporter/internal/openstack"
 	"openstack-reporter/internal/version"
 )
 
@@ -25,6 +26,9 @@ func main() {
 		log.Println("No .env file found, using system environment variables")
 	}
 
+	// Report which OpenStack credentials will be used
+	log.Printf("OpenStack auth method: %s", openstack.DescribeAuth())
+
 	// Initialize web server
 	r := gin.Default()
 
@@ -217,6 +221,13 @@ func setupRoutes(r *gin.Engine) {
 			protected.POST("/refresh/progress", handler.RefreshWithProgress)
 			protected.GET("/progress", handler.GetProgress)
 			protected.GET("/export/pdf", handler.ExportToPDF)
+			protected.GET("/audit/security-groups", handler.GetSecurityGroupAudit)
+			protected.GET("/quotas", handler.GetQuotas)
+			protected.GET("/capacity", handler.GetCapacity)
+			protected.GET("/audit/ports", handler.GetOrphanedPortAudit)
+			protected.GET("/audit/dns", handler.GetDNSAudit)
+			protected.GET("/audit/loadbalancers", handler.GetLoadBalancerHealth)
+			protected.GET("/projects/:id/members", handler.GetProjectMembers)
 		}
 	}
 
@@ -232,6 +243,13 @@ func setupRoutes(r *gin.Engine) {
 	log.Println("    POST /api/refresh/progress")
 	log.Println("    GET  /api/progress")
 	log.Println("    GET  /api/export/pdf")
+	log.Println("    GET  /api/audit/security-groups")
+	log.Println("    GET  /api/quotas")
+	log.Println("    GET  /api/capacity")
+	log.Println("    GET  /api/audit/ports")
+	log.Println("    GET  /api/audit/dns")
+	log.Println("    GET  /api/audit/loadbalancers")
+	log.Println("    GET  /api/projects/:id/members")
 
 	// Web routes
 	r.GET("/", indexHandler)
@@ -286,6 +304,11 @@ func getAPIDocs(c *gin.Context) {
 					{"name": "project_id", "type": "query", "description": "Filter by project ID(s), comma-separated (e.g., 'id1,id2')"},
 					{"name": "type", "type": "query", "description": "Filter by resource type(s), comma-separated (e.g., 'server,volume,network')"},
 					{"name": "status", "type": "query", "description": "Filter by status, comma-separated (e.g., 'active,available')"},
+					{"name": "region", "type": "query", "description": "Filter by region(s), comma-separated (e.g., 'RegionOne,RegionTwo')"},
+					{"name": "cloud", "type": "query", "description": "Filter by cloud(s) from OS_CLOUDS, comma-separated (e.g., 'prod,staging')"},
+					{"name": "stack", "type": "query", "description": "Filter by owning Heat stack name(s) or ID(s), comma-separated; 'none' matches resources not created by any stack"},
+					{"name": "metadata", "type": "query", "description": "Filter servers by metadata, comma-separated 'key=value' or 'key' entries (e.g., 'owner=alice,cost_center')"},
+					{"name": "tag", "type": "query", "description": "Filter servers by tag(s), comma-separated (e.g., 'prod,web')"},
 				},
 				"response": map[string]interface{}{
 					"type": "object",
@@ -303,7 +326,9 @@ func getAPIDocs(c *gin.Context) {
 				"path":        "/api/refresh",
 				"description": "Force refresh all resources from OpenStack API",
 				"auth_required": true,
-				"parameters":  []map[string]string{},
+				"parameters": []map[string]string{
+					{"name": "cloud", "type": "query", "description": "Refresh only this cloud from OS_CLOUDS, keeping the others from the stored report (optional)"},
+				},
 				"response": map[string]interface{}{
 					"type": "object",
 					"properties": map[string]interface{}{
@@ -317,7 +342,9 @@ func getAPIDocs(c *gin.Context) {
 				"path":        "/api/refresh/progress",
 				"description": "Force refresh all resources from OpenStack API with progress updates via SSE",
 				"auth_required": true,
-				"parameters":  []map[string]string{},
+				"parameters": []map[string]string{
+					{"name": "cloud", "type": "query", "description": "Refresh only this cloud from OS_CLOUDS, keeping the others from the stored report (optional)"},
+				},
 				"response": map[string]interface{}{
 					"type":        "text/event-stream",
 					"description": "Server-Sent Events stream with progress updates",
@@ -332,7 +359,7 @@ func getAPIDocs(c *gin.Context) {
 				"response": map[string]interface{}{
 					"type": "object",
 					"properties": map[string]interface{}{
-						"status":    map[string]string{"type": "string", "description": "Current status (in_progress, completed, error)"},
+						"status":    map[string]string{"type": "string", "description": "Current status (in_progress, completed, error, timeout)"},
 						"progress":  map[string]string{"type": "number", "description": "Progress percentage (0-100)"},
 						"message":   map[string]string{"type": "string", "description": "Current progress message"},
 					},
@@ -411,6 +438,150 @@ func getAPIDocs(c *gin.Context) {
 					},
 				},
 			},
+			{
+				"method":        "GET",
+				"path":          "/api/audit/security-groups",
+				"description":   "Security group rules open to 0.0.0.0/0 or ::/0 on sensitive ports (SSH, RDP, databases), grouped per project",
+				"auth_required": true,
+				"parameters": []map[string]string{
+					{"name": "project", "type": "query", "description": "Filter by project name(s), comma-separated"},
+					{"name": "cloud", "type": "query", "description": "Filter by cloud(s), comma-separated"},
+				},
+				"response": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"projects": map[string]string{"type": "array", "description": "Projects with risky rules, each with its security group rules and affected servers"},
+						"total_projects": map[string]string{"type": "number", "description": "Number of projects with findings"},
+						"total_rules": map[string]string{"type": "number", "description": "Number of risky rules"},
+						"generated_at": map[string]string{"type": "string", "description": "Report generation timestamp"},
+					},
+				},
+			},
+			{
+				"method":        "GET",
+				"path":          "/api/quotas",
+				"description":   "Nova, Cinder and Neutron quotas per project with percentage used",
+				"auth_required": true,
+				"parameters": []map[string]string{
+					{"name": "threshold", "type": "query", "description": "Only return quotas used at least this many percent (e.g., 80)"},
+					{"name": "project", "type": "query", "description": "Filter by project name(s), comma-separated"},
+					{"name": "project_id", "type": "query", "description": "Filter by project ID(s), comma-separated"},
+					{"name": "region", "type": "query", "description": "Filter by region(s), comma-separated"},
+					{"name": "cloud", "type": "query", "description": "Filter by cloud(s), comma-separated"},
+				},
+				"response": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"projects": map[string]string{"type": "array", "description": "Projects with quotas (service, resource, limit, in_use, reserved, percent_used, near_limit); a negative limit means unlimited"},
+						"total_projects": map[string]string{"type": "number", "description": "Number of projects returned"},
+						"total_near_limit": map[string]string{"type": "number", "description": "Number of quotas used at least threshold percent"},
+						"threshold": map[string]string{"type": "number", "description": "Near-limit threshold in percent, 80 by default"},
+						"generated_at": map[string]string{"type": "string", "description": "Report generation time"},
+					},
+				},
+			},
+			{
+				"method":        "GET",
+				"path":          "/api/capacity",
+				"description":   "Hypervisor, host aggregate and availability zone capacity per region with servers per host and project (admin credentials only)",
+				"auth_required": true,
+				"parameters": []map[string]string{
+					{"name": "region", "type": "query", "description": "Filter by region(s), comma-separated"},
+					{"name": "cloud", "type": "query", "description": "Filter by cloud(s), comma-separated"},
+				},
+				"response": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"regions": map[string]string{"type": "array", "description": "Capacity per region: totals, hypervisors with per-project usage, aggregates and availability zones"},
+						"totals": map[string]string{"type": "object", "description": "vCPU, RAM and disk totals versus used and running VMs of all returned regions"},
+						"total_hypervisors": map[string]string{"type": "number", "description": "Number of hypervisors"},
+						"message": map[string]string{"type": "string", "description": "Present when no capacity was collected"},
+						"generated_at": map[string]string{"type": "string", "description": "Report generation time"},
+					},
+				},
+			},
+			{
+				"method":        "GET",
+				"path":          "/api/audit/ports",
+				"description":   "Ports that are DOWN, have no device or belong to a deleted server or load balancer, grouped per project",
+				"auth_required": true,
+				"parameters": []map[string]string{
+					{"name": "project", "type": "query", "description": "Filter by project name(s), comma-separated"},
+					{"name": "region", "type": "query", "description": "Filter by region(s), comma-separated"},
+					{"name": "cloud", "type": "query", "description": "Filter by cloud(s), comma-separated"},
+				},
+				"response": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"projects": map[string]string{"type": "array", "description": "Projects with orphaned ports, each port with network, device, fixed IPs and reasons (down, no_device, deleted_server, deleted_load_balancer)"},
+						"total_projects": map[string]string{"type": "number", "description": "Number of projects with orphaned ports"},
+						"total_ports": map[string]string{"type": "number", "description": "Number of orphaned ports"},
+						"generated_at": map[string]string{"type": "string", "description": "Report generation timestamp"},
+					},
+				},
+			},
+			{
+				"method":        "GET",
+				"path":          "/api/audit/dns",
+				"description":   "DNS records pointing at addresses of cloud subnets that no port or floating IP holds, grouped per project",
+				"auth_required": true,
+				"parameters": []map[string]string{
+					{"name": "project", "type": "query", "description": "Filter by project name(s), comma-separated"},
+					{"name": "region", "type": "query", "description": "Filter by region(s), comma-separated"},
+					{"name": "cloud", "type": "query", "description": "Filter by cloud(s), comma-separated"},
+				},
+				"response": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"projects": map[string]string{"type": "array", "description": "Projects with dangling DNS records, each record with zone, type, records and unallocated addresses"},
+						"total_projects": map[string]string{"type": "number", "description": "Number of projects with dangling DNS records"},
+						"total_records": map[string]string{"type": "number", "description": "Number of dangling DNS records"},
+						"generated_at": map[string]string{"type": "string", "description": "Report generation timestamp"},
+					},
+				},
+			},
+			{
+				"method":        "GET",
+				"path":          "/api/audit/loadbalancers",
+				"description":   "Load balancer health: listener, pool and member counts with members in ERROR or OFFLINE state, unhealthy load balancers first",
+				"auth_required": true,
+				"parameters": []map[string]string{
+					{"name": "all", "type": "query", "description": "Set to true to include healthy load balancers"},
+					{"name": "project", "type": "query", "description": "Filter by project name(s), comma-separated"},
+					{"name": "region", "type": "query", "description": "Filter by region(s), comma-separated"},
+					{"name": "cloud", "type": "query", "description": "Filter by cloud(s), comma-separated"},
+				},
+				"response": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"load_balancers": map[string]string{"type": "array", "description": "Load balancers with VIP, statuses, listener, pool and member counts and unhealthy members"},
+						"total_unhealthy": map[string]string{"type": "number", "description": "Number of unhealthy load balancers"},
+						"total_unhealthy_members": map[string]string{"type": "number", "description": "Number of members in ERROR or OFFLINE state"},
+						"generated_at": map[string]string{"type": "string", "description": "Report generation timestamp"},
+					},
+				},
+			},
+			{
+				"method":        "GET",
+				"path":          "/api/projects/:id/members",
+				"description":   "Get users and groups with roles on a project",
+				"auth_required": true,
+				"parameters": []map[string]string{
+					{"name": "cloud", "type": "query", "description": "Cloud of the project when several clouds are configured"},
+				},
+				"response": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"project_id": map[string]string{"type": "string", "description": "Project ID"},
+						"project_name": map[string]string{"type": "string", "description": "Project name"},
+						"cloud": map[string]string{"type": "string", "description": "Cloud name"},
+						"members": map[string]string{"type": "array", "description": "Users and groups with their roles, groups with their users"},
+						"total_members": map[string]string{"type": "number", "description": "Number of members"},
+						"message": map[string]string{"type": "string", "description": "Set when no role assignments were collected"},
+						"generated_at": map[string]string{"type": "string", "description": "Report generation timestamp"},
+					},
+				},
+			},
 		},
 		"authentication": map[string]interface{}{
 			"api_auth": map[string]interface{}{
@@ -432,7 +603,14 @@ func getAPIDocs(c *gin.Context) {
 					"OS_AUTH_URL",
 					"OS_IDENTITY_API_VERSION",
 					"OS_AUTH_TYPE",
+					"OS_APPLICATION_CREDENTIAL_ID",
+					"OS_APPLICATION_CREDENTIAL_NAME",
+					"OS_APPLICATION_CREDENTIAL_SECRET",
 					"OS_INSECURE",
+					"OS_REGION_NAME",
+					"OS_REGIONS",
+					"OS_CLOUD",
+					"OS_CLOUDS",
 				},
 			},
 		},
@@ -441,19 +619,33 @@ func getAPIDocs(c *gin.Context) {
 			{"name": "Servers", "description": "Virtual machines with Flavor and network info (Nova)"},
 			{"name": "Volumes", "description": "Block storage volumes with attachment details (Cinder)"},
 			{"name": "Networks", "description": "Network resources with subnet information (Neutron)"},
-			{"name": "Load Balancers", "description": "Load balancing services with IP addresses (Octavia)"},
+			{"name": "Load Balancers", "description": "Load balancing services with IP addresses, listeners, pools, health monitors and members (Octavia)"},
 			{"name": "Floating IPs", "description": "Public IP addresses with attachment info (Neutron)"},
 			{"name": "Routers", "description": "Network routers (Neutron)"},
 			{"name": "VPN Connections", "description": "IPSec site-to-site connections with peer info (Neutron VPNaaS)"},
 			{"name": "Kubernetes Clusters", "description": "Kubernetes clusters managed by Magnum"},
+			{"name": "Images", "description": "Glance images with the servers booted from them"},
+			{"name": "Security Groups", "description": "Security groups with rules, ports and servers (Neutron)"},
+			{"name": "Volume Snapshots", "description": "Cinder volume snapshots with source volume and age"},
+			{"name": "Volume Backups", "description": "Cinder volume backups with source volume and age"},
+			{"name": "Ports", "description": "Neutron ports with device, fixed IPs, security groups and orphan detection"},
+			{"name": "Object Storage Containers", "description": "Swift containers with object count, size and public/private ACL"},
+			{"name": "DNS Zones", "description": "Designate zones with type, serial and recordset count"},
+			{"name": "DNS Recordsets", "description": "Designate recordsets cross-referenced with floating IPs and ports"},
+			{"name": "Stacks", "description": "Heat stacks with their resources, linked to the resources they created"},
 		},
 		"filtering": map[string]interface{}{
 			"description": "The /api/resources endpoint supports filtering via query parameters",
 			"filters": []map[string]string{
 				{"name": "project", "description": "Filter by project name(s), comma-separated (e.g., 'project1,project2')"},
 				{"name": "project_id", "description": "Filter by project ID(s), comma-separated (e.g., 'id1,id2')"},
-				{"name": "type", "description": "Filter by resource type(s), comma-separated. Available types: server, volume, network, load_balancer, floating_ip, router, vpn_service, cluster"},
+				{"name": "type", "description": "Filter by resource type(s), comma-separated. Available types: server, volume, network, load_balancer, floating_ip, router, vpn_service, cluster, image, security_group, volume_snapshot, volume_backup, port, container, dns_zone, dns_recordset, stack"},
 				{"name": "status", "description": "Filter by status, comma-separated (e.g., 'active,available')"},
+				{"name": "region", "description": "Filter by region(s), comma-separated (e.g., 'RegionOne,RegionTwo')"},
+				{"name": "cloud", "description": "Filter by cloud(s) from OS_CLOUDS, comma-separated (e.g., 'prod,staging')"},
+				{"name": "stack", "description": "Filter by owning Heat stack name(s) or ID(s), comma-separated; 'none' matches resources not created by any stack"},
+				{"name": "metadata", "description": "Filter servers by metadata, comma-separated 'key=value' or 'key' entries; other resource types are excluded"},
+				{"name": "tag", "description": "Filter servers by tag(s), comma-separated; other resource types are excluded"},
 			},
 			"examples": []string{
 				"/api/resources?project=infra&type=server,volume",
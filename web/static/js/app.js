@@ -134,7 +134,7 @@ class OpenStackReporter {
 		// Store for potential cancellation
 		this.currentEventSource = eventSource;
 
-		// Cancel button handler
+		// Cancel button handler, closing the stream also stops the refresh on the server
 		document.getElementById('progressCancelBtn').onclick = () => {
 			if (this.currentEventSource) {
 				this.currentEventSource.close();
@@ -147,6 +147,12 @@ class OpenStackReporter {
 	handleProgressMessage(data) {
 		console.log('Progress update:', data);
 
+		// The same project name can appear in several clouds and regions
+		let project = data.cloud ? `${data.cloud} / ${data.project}` : data.project;
+		if (data.region) {
+			project = `${project} @ ${data.region}`;
+		}
+
 		switch (data.type) {
 			case 'start':
 				this.updateProgress(5, data.message);
@@ -161,32 +167,36 @@ class OpenStackReporter {
 					Math.round((data.current_step / data.total_steps) * 80) + 10,
 					`[${data.current_step}/${data.total_steps}] ${data.message}`
 				);
-				this.addProjectToList(data.project, 'progress', 'Сбор данных...');
+				this.addProjectToList(project, 'progress', 'Сбор данных...');
 				break;
 
 			case 'resource_start':
-				this.updateProjectResource(data.project, data.resource_type, 'progress', `Сбор ${data.resource_type}...`);
+				this.updateProjectResource(project, data.resource_type, 'progress', `Сбор ${data.resource_type}...`);
 				break;
 
 			case 'resource_complete':
 				const count = data.count || 0;
-				this.updateProjectResource(data.project, data.resource_type, 'success', `${count} найдено`);
+				this.updateProjectResource(project, data.resource_type, 'success', `${count} найдено`);
 				break;
 
 			case 'resource_error':
-				this.updateProjectResource(data.project, data.resource_type, 'danger', 'Ошибка');
+				this.updateProjectResource(project, data.resource_type, 'danger', 'Ошибка');
 				break;
 
 			case 'project_complete':
-				this.updateProjectStatus(data.project, 'success', `${data.count} ресурсов`);
+				this.updateProjectStatus(project, 'success', `${data.count} ресурсов`);
+				if (data.retries) {
+					this.updateProgress(Math.round((data.current_step / data.total_steps) * 80) + 10,
+						`${data.message} (повторных запросов к API: ${data.retries})`);
+				}
 				break;
 
 			case 'project_error':
-				this.updateProjectStatus(data.project, 'danger', 'Ошибка');
+				this.updateProjectStatus(project, 'danger', 'Ошибка');
 				break;
 
 			case 'summary':
-				this.updateProgress(95, data.message);
+				this.updateProgress(95, data.retries ? `${data.message} (повторных запросов к API: ${data.retries})` : data.message);
 				this.showResourceSummary(data.summary);
 				break;
 
@@ -213,6 +223,17 @@ class OpenStackReporter {
 				document.getElementById('progressCancelBtn').style.display = 'none';
 				document.getElementById('progressDoneBtn').style.display = 'block';
 
+				if (this.currentEventSource) {
+					this.currentEventSource.close();
+				}
+				break;
+
+			case 'timeout':
+				this.updateProgress(100, 'Превышено время обновления: ' + data.message);
+				document.getElementById('currentStatus').className = 'alert alert-warning';
+				document.getElementById('progressCancelBtn').style.display = 'none';
+				document.getElementById('progressDoneBtn').style.display = 'block';
+
 				if (this.currentEventSource) {
 					this.currentEventSource.close();
 				}
@@ -311,7 +332,18 @@ class OpenStackReporter {
 			'networks': 'Сети',
 			'load_balancers': 'Load Balancers',
 			'vpn_connections': 'VPN',
-			'k8s_clusters': 'K8s кластеры'
+			'k8s_clusters': 'K8s кластеры',
+			'images': 'Образы',
+			'security_groups': 'Группы безопасности',
+			'volume_snapshots': 'Снапшоты дисков',
+			'volume_backups': 'Бэкапы дисков',
+			'quotas': 'Квоты',
+			'capacity': 'Мощности гипервизоров',
+			'ports': 'Порты',
+			'containers': 'Контейнеры Swift',
+			'dns_zones': 'DNS зоны',
+			'stacks': 'Стеки Heat',
+			'access': 'Доступ к проектам'
 		};
 		return labels[resourceType] || resourceType;
 	}
@@ -654,6 +686,7 @@ class OpenStackReporter {
                 <p><strong>Тип:</strong> ${this.getTypeDisplayName(resource.type)}</p>
                 <p><strong>Проект:</strong> ${resource.project_name}</p>
                 <p><strong>Статус:</strong> ${resource.status}</p>
+                ${resource.stack ? `<p><strong>Стек Heat:</strong> ${resource.stack.name}</p>` : ''}
                 <p><strong>Создан:</strong> ${new Date(resource.created_at).toLocaleString('ru-RU')}</p>
                 ${resource.updated_at ? `<p><strong>Обновлен:</strong> ${new Date(resource.updated_at).toLocaleString('ru-RU')}</p>` : ''}
             </div>
@@ -674,7 +707,25 @@ class OpenStackReporter {
 				html += `
                     <p><strong>Flavor:</strong> ${props.flavor_name || 'Unknown'}</p>
                     ${props.flavor_id ? `<p><strong>Flavor ID:</strong> ${props.flavor_id}</p>` : ''}
-
+                    ${props.vcpus ? `<p><strong>Ресурсы:</strong> ${props.vcpus} vCPU, ${props.ram_mb} MB RAM</p>` : ''}
+                    ${props.host ? `<p><strong>Хост:</strong> ${props.host}</p>` : ''}
+                    ${props.availability_zone ? `<p><strong>Зона доступности:</strong> ${props.availability_zone}</p>` : ''}
+                    ${props.image_id ? `<p><strong>Образ:</strong> ${props.image_name || props.image_id}</p>` : ''}
+                    ${props.key_name ? `<p><strong>Ключ SSH:</strong> ${props.key_name}</p>` : ''}
+                    ${props.power_state ? `<p><strong>Питание:</strong> ${props.power_state}</p>` : ''}
+                    <p><strong>Заблокирован:</strong> ${props.locked ? 'Да' : 'Нет'}</p>
+                    ${props.security_groups && props.security_groups.length > 0 ? `<p><strong>Группы безопасности:</strong> ${props.security_groups.join(', ')}</p>` : ''}
+                    ${props.tags && props.tags.length > 0 ? `<p><strong>Теги:</strong> ${props.tags.join(', ')}</p>` : ''}
+                    ${props.attached_volumes && props.attached_volumes.length > 0 ? `<p><strong>Диски:</strong> ${props.attached_volumes.join(', ')}</p>` : ''}
+                `;
+				if (props.metadata && Object.keys(props.metadata).length > 0) {
+					html += '<p><strong>Метаданные:</strong></p><ul>';
+					Object.entries(props.metadata).forEach(([key, value]) => {
+						html += `<li>${key}: ${value}</li>`;
+					});
+					html += '</ul>';
+				}
+				html += `
                     <p><strong>Сети:</strong></p>
                     <ul>
                 `;
@@ -717,6 +768,29 @@ class OpenStackReporter {
 				if (props.attached_resource_name) {
 					html += `<p><strong>Привязан к ресурсу:</strong> ${props.attached_resource_name}</p>`;
 				}
+				if (props.dns_names && props.dns_names.length > 0) {
+					html += `<p><strong>DNS имена:</strong> ${props.dns_names.join(', ')}</p>`;
+				}
+				break;
+
+			case 'network':
+				html += `
+                    <p><strong>Внешняя:</strong> ${props.external ? 'Да' : 'Нет'}</p>
+                    <p><strong>Общая:</strong> ${props.shared ? 'Да' : 'Нет'}</p>
+                    ${props.network_type ? `<p><strong>Тип сети:</strong> ${props.network_type}</p>` : ''}
+                    ${props.physical_network ? `<p><strong>Физическая сеть:</strong> ${props.physical_network}</p>` : ''}
+                    ${props.segmentation_id ? `<p><strong>Segmentation ID:</strong> ${props.segmentation_id}</p>` : ''}
+                    ${props.mtu ? `<p><strong>MTU:</strong> ${props.mtu}</p>` : ''}
+                    ${props.port_security_enabled !== undefined ? `<p><strong>Port security:</strong> ${props.port_security_enabled ? 'Да' : 'Нет'}</p>` : ''}
+                    ${props.availability_zones && props.availability_zones.length > 0 ? `<p><strong>Зоны доступности:</strong> ${props.availability_zones.join(', ')}</p>` : ''}
+                `;
+				if (props.subnets && props.subnets.length > 0) {
+					html += '<p><strong>Подсети:</strong></p><ul>';
+					props.subnets.forEach(subnet => {
+						html += `<li>${subnet.name || subnet.id}: ${subnet.cidr}</li>`;
+					});
+					html += '</ul>';
+				}
 				break;
 
 			case 'vpn_service':
@@ -750,6 +824,180 @@ class OpenStackReporter {
                     <p><strong>Статус провизионирования:</strong> ${props.provisioning_status}</p>
                     <p><strong>Операционный статус:</strong> ${props.operating_status}</p>
                 `;
+				(props.listeners || []).forEach(listener => {
+					html += `<p><strong>Listener:</strong> ${listener.name || listener.id} (${listener.protocol}:${listener.protocol_port}, ${listener.operating_status})</p>`;
+					if (listener.default_tls_container_ref) {
+						html += `<p class="ms-3"><strong>TLS:</strong> ${listener.default_tls_container_ref}</p>`;
+					}
+					if (listener.sni_container_refs && listener.sni_container_refs.length) {
+						html += `<p class="ms-3"><strong>SNI:</strong> ${listener.sni_container_refs.join(', ')}</p>`;
+					}
+				});
+				(props.pools || []).forEach(pool => {
+					const monitor = pool.health_monitor ? `, монитор ${pool.health_monitor.type}` : ', без монитора';
+					html += `<p><strong>Пул:</strong> ${pool.name || pool.id} (${pool.protocol}, ${pool.lb_algorithm}, ${pool.operating_status}${monitor})</p>`;
+					(pool.members || []).forEach(member => {
+						// Выделяем участников в ERROR/OFFLINE
+						const unhealthy = member.operating_status === 'ERROR' || member.operating_status === 'OFFLINE' || member.provisioning_status === 'ERROR';
+						const memberText = `${member.name || member.id} ${member.address}:${member.protocol_port} - ${member.operating_status}`;
+						html += unhealthy
+							? `<p class="ms-3 text-danger">⚠️ ${memberText}</p>`
+							: `<p class="ms-3">${memberText}</p>`;
+					});
+				});
+				break;
+
+			case 'cluster':
+				html += `
+                    <p><strong>Шаблон:</strong> ${props.cluster_template_name || props.cluster_template_id}</p>
+                    <p><strong>Версия COE:</strong> ${props.coe_version || 'Неизвестно'}</p>
+                    <p><strong>API адрес:</strong> ${props.api_address || 'Нет'}</p>
+                    <p><strong>Мастеры / ноды:</strong> ${props.master_count} / ${props.node_count}</p>
+                    <p><strong>Keypair:</strong> ${props.keypair || 'Не указан'}</p>
+                `;
+				if (props.status_reason) {
+					html += `<p><strong>Причина статуса:</strong> ${props.status_reason}</p>`;
+				}
+				if (props.node_groups && props.node_groups.length > 0) {
+					html += `<p><strong>Группы нод:</strong></p><ul>`;
+					props.node_groups.forEach(nodeGroup => {
+						html += `<li>${nodeGroup.name} (${nodeGroup.role}): ${nodeGroup.node_count} нод, ${nodeGroup.status}</li>`;
+					});
+					html += '</ul>';
+				}
+				break;
+
+			case 'image':
+				html += `
+                    <p><strong>Видимость:</strong> ${props.visibility}</p>
+                    <p><strong>Размер:</strong> ${this.formatBytes(props.size_bytes)}</p>
+                    <p><strong>Формат:</strong> ${props.disk_format || '❓'} / ${props.container_format || '❓'}</p>
+                    <p><strong>ОС:</strong> ${[props.os_distro, props.os_version, props.os_type].filter(Boolean).join(' ') || 'Не указана'}</p>
+                    ${props.architecture ? `<p><strong>Архитектура:</strong> ${props.architecture}</p>` : ''}
+                    <p><strong>Защищен:</strong> ${props.protected ? 'Да' : 'Нет'}</p>
+                `;
+				if (props.servers && props.servers.length > 0) {
+					html += `<p><strong>Серверы из образа:</strong></p><ul>`;
+					props.servers.forEach(server => {
+						html += `<li>${server.name || server.id} (${server.project_name})</li>`;
+					});
+					html += '</ul>';
+				} else {
+					html += `<p><strong>Серверы из образа:</strong> нет</p>`;
+				}
+				break;
+
+			case 'security_group':
+				if (props.description) {
+					html += `<p><strong>Описание:</strong> ${props.description}</p>`;
+				}
+				html += `<p><strong>Правила:</strong></p><ul>`;
+				(props.rules || []).forEach(rule => {
+					const ports = rule.port_range_min || rule.port_range_max
+						? (rule.port_range_min === rule.port_range_max ? rule.port_range_min : `${rule.port_range_min}-${rule.port_range_max}`)
+						: 'все порты';
+					const remote = rule.remote_ip_prefix || (rule.remote_group_id ? `группа ${rule.remote_group_id}` : 'любой адрес');
+					html += `<li>${rule.direction} ${rule.ethertype} ${rule.protocol || 'any'} ${ports} — ${remote}</li>`;
+				});
+				html += '</ul>';
+				if (props.servers && props.servers.length > 0) {
+					html += `<p><strong>Серверы:</strong> ${props.servers.map(server => server.name || server.id).join(', ')}</p>`;
+				}
+				html += `<p><strong>Портов:</strong> ${props.ports ? props.ports.length : 0}</p>`;
+				break;
+
+			case 'port':
+				html += `
+                    <p><strong>Сеть:</strong> ${props.network_name || props.network_id}</p>
+                    <p><strong>MAC:</strong> ${props.mac_address}</p>
+                    <p><strong>IP адреса:</strong> ${(props.fixed_ips || []).map(ip => ip.ip_address).join(', ') || 'Нет'}</p>
+                    <p><strong>Владелец устройства:</strong> ${props.device_owner || 'Нет'}</p>
+                    ${props.device_id ? `<p><strong>Устройство:</strong> ${props.device_name || props.device_id}</p>` : ''}
+                    <p><strong>Группы безопасности:</strong> ${(props.security_groups || []).length}</p>
+                `;
+				if (props.orphan_reasons && props.orphan_reasons.length > 0) {
+					html += `<p><strong>Осиротевший порт:</strong> ${props.orphan_reasons.join(', ')}</p>`;
+				}
+				break;
+
+			case 'stack':
+				html += `
+                    ${props.status_reason ? `<p><strong>Причина статуса:</strong> ${props.status_reason}</p>` : ''}
+                    ${props.parent_id ? `<p><strong>Родительский стек:</strong> ${props.parent_name || props.parent_id}</p>` : ''}
+                    ${props.description ? `<p><strong>Описание:</strong> ${props.description}</p>` : ''}
+                    ${props.tags && props.tags.length > 0 ? `<p><strong>Теги:</strong> ${props.tags.join(', ')}</p>` : ''}
+                    <p><strong>Ресурсы стека:</strong></p>
+                    <ul>
+                `;
+				(props.resources || []).forEach(stackResource => {
+					html += `<li>${stackResource.name} (${stackResource.type}) - ${stackResource.status}</li>`;
+				});
+				html += '</ul>';
+				break;
+
+			case 'dns_zone':
+				html += `
+                    <p><strong>Тип:</strong> ${props.type}</p>
+                    <p><strong>Email:</strong> ${props.email || 'Нет'}</p>
+                    <p><strong>TTL:</strong> ${props.ttl}</p>
+                    <p><strong>Серийный номер:</strong> ${props.serial}</p>
+                    <p><strong>Записей:</strong> ${props.recordsets}</p>
+                `;
+				if (props.masters && props.masters.length > 0) {
+					html += `<p><strong>Master серверы:</strong> ${props.masters.join(', ')}</p>`;
+				}
+				break;
+
+			case 'dns_recordset':
+				html += `
+                    <p><strong>Зона:</strong> ${props.zone_name}</p>
+                    <p><strong>Тип:</strong> ${props.type}</p>
+                    <p><strong>Значения:</strong> ${(props.records || []).join(', ')}</p>
+                    ${props.ttl ? `<p><strong>TTL:</strong> ${props.ttl}</p>` : ''}
+                `;
+				if (props.unallocated_addresses && props.unallocated_addresses.length > 0) {
+					html += `<p><strong>⚠️ Незанятые адреса:</strong> ${props.unallocated_addresses.join(', ')}</p>`;
+				}
+				break;
+
+			case 'container':
+				html += `
+                    <p><strong>Объектов:</strong> ${props.object_count}</p>
+                    <p><strong>Объем:</strong> ${this.formatBytes(props.bytes_used)}</p>
+                    <p><strong>Доступ:</strong> ${props.public ? '⚠️ Публичный' : 'Приватный'}</p>
+                    ${props.read_acl && props.read_acl.length > 0 ? `<p><strong>ACL чтения:</strong> ${props.read_acl.join(', ')}</p>` : ''}
+                    ${props.write_acl && props.write_acl.length > 0 ? `<p><strong>ACL записи:</strong> ${props.write_acl.join(', ')}</p>` : ''}
+                    ${props.storage_policy ? `<p><strong>Политика хранения:</strong> ${props.storage_policy}</p>` : ''}
+                `;
+				break;
+
+			case 'object_account':
+				html += `
+                    <p><strong>Контейнеров:</strong> ${props.container_count}</p>
+                    <p><strong>Объектов:</strong> ${props.object_count}</p>
+                    <p><strong>Объем:</strong> ${this.formatBytes(props.bytes_used)}</p>
+                    ${props.quota_bytes != null ? `<p><strong>Квота:</strong> ${this.formatBytes(props.quota_bytes)}</p>` : ''}
+                `;
+				break;
+
+			case 'volume_snapshot':
+			case 'volume_backup':
+				html += `
+                    <p><strong>Размер:</strong> ${props.size} GB</p>
+                    <p><strong>Исходный диск:</strong> ${props.volume_name || props.volume_id || 'Неизвестно'}${props.volume_exists ? '' : ' (удален)'}</p>
+                    <p><strong>Возраст:</strong> ${props.age_days} дн.</p>
+                `;
+				if (props.description) {
+					html += `<p><strong>Описание:</strong> ${props.description}</p>`;
+				}
+				if (resource.type === 'volume_backup') {
+					html += `
+                    <p><strong>Инкрементальный:</strong> ${props.incremental ? 'Да' : 'Нет'}</p>
+                    ${props.container ? `<p><strong>Контейнер:</strong> ${props.container}</p>` : ''}
+                    <p><strong>Объектов:</strong> ${props.object_count}</p>
+                    ${props.fail_reason ? `<p><strong>Причина ошибки:</strong> ${props.fail_reason}</p>` : ''}
+                `;
+				}
 				break;
 		}
 
@@ -826,13 +1074,35 @@ class OpenStackReporter {
 			'network': 'Сеть',
 			'load_balancer': 'Балансировщик',
 			'vpn_service': 'VPN сервис',
-			'cluster': 'Kubernetes кластер'
+			'cluster': 'Kubernetes кластер',
+			'image': 'Образ',
+			'security_group': 'Группа безопасности',
+			'volume_snapshot': 'Снапшот диска',
+			'volume_backup': 'Бэкап диска',
+			'port': 'Порт',
+			'container': 'Контейнер Swift',
+			'object_account': 'Аккаунт Swift',
+			'dns_zone': 'DNS зона',
+			'dns_recordset': 'DNS запись',
+			'stack': 'Стек Heat'
 		};
 		return types[type] || type;
 	}
 
 
 
+	formatBytes(bytes) {
+		if (!bytes) return '0 B';
+		const units = ['B', 'KiB', 'MiB', 'GiB', 'TiB'];
+		let value = bytes;
+		let unit = 0;
+		while (value >= 1024 && unit < units.length - 1) {
+			value /= 1024;
+			unit++;
+		}
+		return `${value.toFixed(unit === 0 ? 0 : 1)} ${units[unit]}`;
+	}
+
 	getGroupIcon(groupBy) {
 		const icons = {
 			'project': 'folder',
@@ -873,11 +1143,12 @@ class OpenStackReporter {
 				return `Type: ${volume_type}, Boot: ${volume_bootable}, Size: ${volume_size} GB`;
 
 			case 'floating_ip':
-				// Показываем к чему подключен
+				// Показываем DNS имя и к чему подключен
+				let fip_dns = props.dns_names && props.dns_names.length > 0 ? `${props.dns_names[0]}, ` : '';
 				if (props.attached_resource_name) {
-					return `Подключен к: ${props.attached_resource_name}`;
+					return `${fip_dns}Подключен к: ${props.attached_resource_name}`;
 				}
-				return 'Не подключен';
+				return `${fip_dns}Не подключен`;
 
 			case 'load_balancer':
 				// Показываем внутренний IP (и внешний если есть)
@@ -888,13 +1159,18 @@ class OpenStackReporter {
 				if (props.floating_ip && props.floating_ip !== props.vip_address) {
 					ips.push(props.floating_ip);
 				}
-				return ips.length > 0 ? ips.join(', ') : 'Нет IP';
+				// Отмечаем участников пулов в ERROR/OFFLINE
+				let lb_unhealthy = (props.pools || []).reduce((count, pool) => count + (pool.members || []).filter(member =>
+					member.operating_status === 'ERROR' || member.operating_status === 'OFFLINE' || member.provisioning_status === 'ERROR').length, 0);
+				let lb_ips = ips.length > 0 ? ips.join(', ') : 'Нет IP';
+				return lb_unhealthy > 0 ? `${lb_ips}, ⚠️ неисправных участников: ${lb_unhealthy}` : lb_ips;
 
 			case 'network':
 				// Показываем подсети и статус shared/external
 				let subnet_count = props.subnets ? props.subnets.length : 0;
 				let external = props.external ? '🌐' : '🏠';
 				let shared = props.shared ? '🔗' : '🔒';
+				let network_type = props.network_type ? `, ${props.network_type}` : '';
 
 				if (subnet_count > 0) {
 					// Показываем первые 2 подсети с CIDR
@@ -902,14 +1178,68 @@ class OpenStackReporter {
 					if (subnet_count > 2) {
 						subnet_info += ` (+${subnet_count - 2})`;
 					}
-					return `Subnets: ${subnet_info}, ${external}${shared}`;
+					return `Subnets: ${subnet_info}, ${external}${shared}${network_type}`;
 				}
-				return `No subnets, ${external}${shared}`;
+				return `No subnets, ${external}${shared}${network_type}`;
 
 			case 'vpn_service':
 				// Показываем Peer Address
 				return props.peer_address || 'Нет Peer Address';
 
+			case 'cluster':
+				// Показываем версию и количество нод
+				return `${props.coe_version || 'COE ❓'}, Masters: ${props.master_count}, Nodes: ${props.node_count}`;
+
+			case 'image':
+				// Показываем размер, видимость и использование
+				let image_servers = props.servers ? props.servers.length : 0;
+				let image_usage = image_servers > 0 ? `VMs: ${image_servers}` : 'Не используется';
+				return `${props.visibility}, ${this.formatBytes(props.size_bytes)}, ${image_usage}`;
+
+			case 'security_group':
+				// Показываем количество правил и использующих серверов
+				let sg_rules = props.rules ? props.rules.length : 0;
+				let sg_servers = props.servers ? props.servers.length : 0;
+				return `Rules: ${sg_rules}, VMs: ${sg_servers}`;
+
+			case 'port':
+				// Показываем адреса и устройство
+				let port_ips = (props.fixed_ips || []).map(ip => ip.ip_address).join(', ') || 'без IP';
+				let port_device = props.device_owner || 'без устройства';
+				return props.orphan_reasons && props.orphan_reasons.length > 0
+					? `${port_ips}, ${port_device}, ⚠️ ${props.orphan_reasons.join(', ')}`
+					: `${port_ips}, ${port_device}`;
+
+			case 'stack':
+				// Показываем количество ресурсов стека
+				return `Ресурсов: ${(props.resources || []).length}`;
+
+			case 'dns_zone':
+				// Показываем тип зоны и количество записей
+				return `${props.type}, записей: ${props.recordsets}`;
+
+			case 'dns_recordset':
+				// Показываем тип и значения записи
+				let dns_records = `${props.type} ${(props.records || []).join(', ')}`;
+				return props.unallocated_addresses && props.unallocated_addresses.length > 0
+					? `${dns_records}, ⚠️ незанятый адрес`
+					: dns_records;
+
+			case 'container':
+				// Показываем объем, число объектов и доступ
+				let container_access = props.public ? '⚠️ публичный' : 'приватный';
+				return `${this.formatBytes(props.bytes_used)}, ${props.object_count} objects, ${container_access}`;
+
+			case 'object_account':
+				// Показываем объем и число контейнеров аккаунта
+				return `${this.formatBytes(props.bytes_used)}, ${props.container_count} containers`;
+
+			case 'volume_snapshot':
+			case 'volume_backup':
+				// Показываем размер, исходный диск и возраст
+				let source_volume = props.volume_exists ? (props.volume_name || props.volume_id) : 'диск удален';
+				return `${props.size} GB, ${source_volume}, ${props.age_days} дн.`;
+
 			default:
 				// Для остальных типов показываем ID
 				return resource.id;